@@ -3,7 +3,9 @@ package main
 import (
 	"flame/internal/config"
 	"flame/internal/services/api"
+	"flame/pkg/db"
 	"flame/pkg/logger"
+	"github.com/go-redis/redis/v8"
 	"log/slog"
 	"os"
 )
@@ -15,9 +17,16 @@ func main() {
 	}
 	conf := config.LoadConfig("configs", mode)
 	log := logger.NewLogger(os.Stdout)
+	rdb := db.NewRedis(&redis.Options{
+		Addr:     conf.GetRedisAddr(),
+		Password: conf.Database.Redis.Password,
+		DB:       conf.Database.Redis.Db,
+		Username: conf.Database.Redis.Username,
+	})
 	app := api.NewApp(&api.AppDeps{
 		Config: conf,
 		Logger: log,
+		Redis:  rdb,
 		Mode:   mode,
 	})
 	err := app.Run()
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flame/internal/config"
+	"flame/internal/services/chat"
+	"flame/pkg/db"
+	"flame/pkg/logger"
+	"log/slog"
+	"os"
+)
+
+func main() {
+	mode := os.Getenv("APP_ENV")
+	if mode == "" {
+		mode = "dev"
+	}
+
+	conf := config.LoadConfig("configs", mode)
+	log := logger.NewLogger(os.Stdout)
+	database := db.NewDb(conf.Database.Chat.Dsn)
+	app := chat.NewApp(&chat.AppDeps{
+		Config: conf,
+		Logger: log,
+		Mode:   mode,
+		DB:     database,
+	})
+	err := app.Run()
+	if err != nil {
+		log.Error(err.Error(),
+			slog.String("Address", conf.Services.Chat.Address),
+			slog.String("Mode", mode),
+		)
+	}
+}
@@ -25,7 +25,7 @@ func initDB(dsn string) (*sql.DB, error) {
 
 func main() {
 	var db string
-	flag.StringVar(&db, "db", "", "database name (account/swipe)")
+	flag.StringVar(&db, "db", "", "database name (account/swipe/chat)")
 	flag.Parse()
 
 	mode := os.Getenv("APP_ENV")
@@ -52,6 +52,16 @@ func main() {
 		}
 		defer dbConn.Close()
 
+		if err := goose.Up(dbConn, fmt.Sprintf("./migrations/%s", db)); err != nil {
+			log.Fatalf("Error migrating %s: %v", db, err)
+		}
+	case "chat":
+		dbConn, err := initDB(conf.Database.Chat.Dsn)
+		if err != nil {
+			log.Fatalf("Failed to connect to chat db: %v", err)
+		}
+		defer dbConn.Close()
+
 		if err := goose.Up(dbConn, fmt.Sprintf("./migrations/%s", db)); err != nil {
 			log.Fatalf("Error migrating %s: %v", db, err)
 		}
@@ -68,11 +78,20 @@ func main() {
 		}
 		defer accountDB.Close()
 
+		chatDB, err := initDB(conf.Database.Chat.Dsn)
+		if err != nil {
+			log.Fatalf("Failed to connect to chat db: %v", err)
+		}
+		defer chatDB.Close()
+
 		if err := goose.Up(swipeDB, "./migrations/swipe"); err != nil {
 			log.Fatalf("Error migrating swipe: %v", err)
 		}
 		if err := goose.Up(accountDB, "./migrations/account"); err != nil {
 			log.Fatalf("Error migrating account: %v", err)
 		}
+		if err := goose.Up(chatDB, "./migrations/chat"); err != nil {
+			log.Fatalf("Error migrating chat: %v", err)
+		}
 	}
 }
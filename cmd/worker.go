@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"flame/internal/config"
+	"flame/internal/services/account"
+	"flame/internal/services/mathcing"
+	"flame/internal/services/swipes"
+	"flame/pkg/db"
+	"flame/pkg/logger"
+	"flame/pkg/scheduler"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func main() {
+	mode := os.Getenv("APP_ENV")
+	if mode == "" {
+		mode = "dev"
+	}
+
+	conf := config.LoadConfig("configs", mode)
+	log := logger.NewLogger(os.Stdout)
+	accDb := db.NewDb(conf.Database.Account.Dsn)
+	swipesDb := db.NewDb(conf.Database.Swipes.Dsn)
+	rdb := db.NewRedis(&redis.Options{
+		Addr:     conf.GetRedisAddr(),
+		Username: conf.Database.Redis.Username,
+		Password: conf.Database.Redis.Password,
+		DB:       conf.Database.Redis.Db,
+	})
+	s3Client, err := config.NewS3Client()
+	if err != nil {
+		log.Error(err.Error(), slog.String("Error location", "config.NewS3Client"))
+		return
+	}
+
+	accountRepository := account.NewRepository(&account.RepositoryDeps{
+		DB:    accDb,
+		Redis: rdb,
+	})
+	swipesRepository := swipes.NewRepository(&swipes.RepositoryDeps{
+		DB:    swipesDb,
+		Redis: rdb,
+	})
+	matchingService := mathcing.NewService(&mathcing.ServiceDeps{
+		Repository: mathcing.NewRepository(&mathcing.RepositoryDeps{
+			AccountDB: accDb,
+			SwipesDB:  swipesDb,
+		}),
+		Logger: log,
+		Redis:  rdb,
+		Config: conf,
+	})
+
+	host, err := os.Hostname()
+	if err != nil {
+		host = "worker"
+	}
+	jobs := scheduler.NewScheduler(&scheduler.SchedulerDeps{
+		DB:       accDb,
+		Redis:    rdb,
+		Logger:   log,
+		Instance: fmt.Sprintf("%s-%d", host, os.Getpid()),
+	})
+	jobs.Add(account.NewJobs(&account.JobsDeps{
+		Repository:      accountRepository,
+		Logger:          log,
+		S3Client:        s3Client,
+		Bucket:          conf.S3.Bucket,
+		OrphanPhotoAge:  conf.Scheduler.OrphanPhotoAge,
+		OutboxRetention: conf.Scheduler.OutboxRetention,
+	})...)
+	jobs.Add(swipes.NewJobs(&swipes.JobsDeps{
+		Repository:      swipesRepository,
+		Logger:          log,
+		OutboxRetention: conf.Scheduler.OutboxRetention,
+	})...)
+	jobs.Add(mathcing.NewJobs(&mathcing.JobsDeps{
+		Service:    matchingService,
+		PoolMaxAge: conf.Scheduler.PoolMaxAge,
+	})...)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	log.Info("Worker starts", slog.String("Mode", mode))
+	jobs.Run(ctx)
+	log.Info("Worker stopped")
+}
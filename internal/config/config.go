@@ -3,6 +3,7 @@ package config
 import (
 	"github.com/spf13/viper"
 	"log"
+	"time"
 )
 
 type Service struct {
@@ -15,6 +16,7 @@ type Config struct {
 		Account  Service `yaml:"account"`
 		Matching Service `yaml:"matching"`
 		Swipes   Service `yaml:"swipes"`
+		Chat     Service `yaml:"chat"`
 	} `yaml:"services"`
 	Database struct {
 		Account struct {
@@ -23,6 +25,9 @@ type Config struct {
 		Swipes struct {
 			Dsn string `yaml:"dsn"`
 		} `yaml:"swipes"`
+		Chat struct {
+			Dsn string `yaml:"dsn"`
+		} `yaml:"chat"`
 		Redis struct {
 			Host     string `yaml:"host"`
 			Port     string `yaml:"port"`
@@ -42,10 +47,49 @@ type Config struct {
 			Port int    `yaml:"port"`
 		} `yaml:"database"`
 	} `yaml:"public"`
+	Cors struct {
+		// AllowedOrigins are the pages, besides the API host itself, that may open a WebSocket.
+		AllowedOrigins []string `yaml:"allowed_origins" mapstructure:"allowed_origins"`
+	} `yaml:"cors"`
 	S3 struct {
 		Bucket   string `yaml:"bucket"`
 		Endpoint string `yaml:"endpoint"`
 	} `yaml:"s3"`
+	Swipes struct {
+		RewindWindow     time.Duration `yaml:"rewind_window" mapstructure:"rewind_window"`
+		SuperLikesPerDay int           `yaml:"super_likes_per_day" mapstructure:"super_likes_per_day"`
+		LikesPerDay      int           `yaml:"likes_per_day" mapstructure:"likes_per_day"`
+	} `yaml:"swipes"`
+	Matching struct {
+		Mode            string         `yaml:"mode"`
+		MismatchPenalty float64        `yaml:"mismatch_penalty" mapstructure:"mismatch_penalty"`
+		RebuildDebounce time.Duration  `yaml:"rebuild_debounce" mapstructure:"rebuild_debounce"`
+		Weights         ScoringWeights `yaml:"weights"`
+	} `yaml:"matching"`
+	Events struct {
+		RelayInterval  time.Duration `yaml:"relay_interval" mapstructure:"relay_interval"`
+		RelayBatchSize int           `yaml:"relay_batch_size" mapstructure:"relay_batch_size"`
+		StreamMaxLen   int64         `yaml:"stream_max_len" mapstructure:"stream_max_len"`
+		IdempotencyTTL time.Duration `yaml:"idempotency_ttl" mapstructure:"idempotency_ttl"`
+		MaxDeliveries  int64         `yaml:"max_deliveries" mapstructure:"max_deliveries"`
+	} `yaml:"events"`
+	Location struct {
+		JumpLimit  int           `yaml:"jump_limit" mapstructure:"jump_limit"`
+		JumpWindow time.Duration `yaml:"jump_window" mapstructure:"jump_window"`
+	} `yaml:"location"`
+	Scheduler struct {
+		PoolMaxAge      time.Duration `yaml:"pool_max_age" mapstructure:"pool_max_age"`
+		OutboxRetention time.Duration `yaml:"outbox_retention" mapstructure:"outbox_retention"`
+		OrphanPhotoAge  time.Duration `yaml:"orphan_photo_age" mapstructure:"orphan_photo_age"`
+	} `yaml:"scheduler"`
+}
+
+type ScoringWeights struct {
+	Distance     float64 `yaml:"distance"`
+	Age          float64 `yaml:"age"`
+	Completeness float64 `yaml:"completeness"`
+	Activity     float64 `yaml:"activity"`
+	LikedMe      float64 `yaml:"liked_me" mapstructure:"liked_me"`
 }
 
 func LoadConfig(path, mode string) *Config {
@@ -4,6 +4,7 @@ import (
 	"flame/internal/models"
 	"flame/pkg/jwt"
 	"flame/pkg/pb"
+	"time"
 )
 
 type AccountService interface {
@@ -15,8 +16,20 @@ type AccountService interface {
 	GetProfile(id int64) (*pb.GetProfileRes, error)
 	UploadPhoto(userId int64, link string) error
 	DeletePhoto(userId, photoId int64) (string, error)
-	UpdateLocation(userId int64, location string) error
+	UpdateLocation(userId int64, location string) (bool, error)
 	UpdatePreferences(prefer *pb.UpdatePreferencesReq) error
+	Block(userId, targetId int64) error
+	Report(report *models.Report) error
+	IsBlocked(userId1, userId2 int64) (bool, error)
+	GetReports(adminId int64, cursor *int64, limit int32) ([]models.Report, *int64, error)
+	ResolveReport(adminId, reportId int64, status string) error
+	GetReportedUser(adminId, userId int64) (*pb.GetReportedUserRes, error)
+	SanctionUser(adminId int64, sanction *models.Sanction) error
+	RemovePhoto(adminId, photoId int64) (string, error)
+	GetStatus(userId int64) (models.UserStatus, error)
+	SetTravel(userId int64, location string, startsAt *string, endsAt string) error
+	GetTravel(userId int64) *models.Travel
+	EndTravel(userId int64) (bool, error)
 }
 type AccountRepository interface {
 	GetById(id int64) *models.User
@@ -33,6 +46,26 @@ type AccountRepository interface {
 	GetPreferences(userId int64) *models.UserPreferences
 	UpdateLocationRedis(key string, lonLat models.LonLat) error
 	UpdatePreferences(prefer *models.UserPreferences) error
+	GetInterestedIn(userId int64) []string
+	SetInterestedIn(userId int64, genders []string) error
+	Block(blockerId, blockedId int64) error
+	IsBlocked(userId1, userId2 int64) (bool, error)
+	CreateReport(report *models.Report) (int64, error)
+	RemoveCandidateFromRedis(candidatesKey string, userId int64) error
+	DeleteCandidatesFromRedis(userId int64) error
+	IncrLocationJumps(userId int64, window time.Duration) (int64, time.Duration, error)
+	GetOpenReports(cursor *int64, limit int32) ([]models.Report, error)
+	GetReport(reportId int64) *models.Report
+	ResolveReport(reportId, adminId int64, status string) error
+	GetReportsByUser(userId int64) []models.Report
+	CreateSanction(sanction *models.Sanction) (int64, error)
+	GetSanctions(userId int64) []models.Sanction
+	SetStatusRedis(userId int64, status models.UserStatus, expiration time.Duration) error
+	CacheActiveStatus(userId int64, expiration time.Duration) error
+	TouchLastActive(userId int64) error
+	SetTravel(userId int64, location string, startsAt, endsAt time.Time) error
+	GetTravel(userId int64) *models.Travel
+	EndTravel(userId int64) (bool, error)
 }
 
 type AccountSRegisterDeps struct {
@@ -0,0 +1,21 @@
+package interfaces
+
+import "flame/internal/models"
+
+type ChatService interface {
+	SendMessage(senderId, recipientId int64, text string) (*models.Message, error)
+	GetMessages(userId, partnerId int64, cursor *int64, limit int32) ([]models.Message, *int64, error)
+	MarkRead(userId, partnerId, messageId int64) (int64, error)
+	GetConversations(userId int64) []models.Conversation
+	DeleteConversation(userId1, userId2 int64) error
+}
+
+type ChatRepository interface {
+	GetOrCreateConversation(userId1, userId2 int64) (*models.Conversation, error)
+	GetConversation(userId1, userId2 int64) *models.Conversation
+	GetConversations(userId int64) []models.Conversation
+	CreateMessage(message *models.Message) (*models.Message, error)
+	GetMessages(conversationId int64, cursor *int64, limit int32) ([]models.Message, error)
+	MarkRead(conversationId, readerId, messageId int64) (int64, error)
+	DeleteConversation(userId1, userId2 int64) error
+}
@@ -1,14 +1,32 @@
 package interfaces
 
-import "flame/internal/models"
+import (
+	"context"
+	"flame/internal/models"
+	"flame/pkg/events"
+	"time"
+)
 
 type MatchingService interface {
-	GetMatchingUsers(userId int64, location string) ([]models.GetMatchingUser, *models.LonLat, error)
+	GetMatchingUsers(userId int64, location string, pageSize int32, pageToken string) ([]models.GetMatchingUser, *models.LonLat, string, error)
 	UpdateRedis(userId int64) error
+	GetLikes(userId int64, pageSize int32, pageToken string) ([]models.LikeCard, *models.LonLat, string, error)
+	HandleAccountEvent(ctx context.Context, event *events.Event) error
 }
 
 type MatchingRepository interface {
-	GetMatchingUsers(userId int64) ([]models.GetMatchingUser, error)
+	GetMatchingUsers(userId int64, strict bool, nearbyIds []int64) ([]models.GetMatchingUser, error)
 	GetLonLat(userId int64) *models.LonLat
 	DeleteDuplicateMatch(userId int64, users []models.GetMatchingUser) []models.GetMatchingUser
+	GetLikes(userId int64, after *models.Like, limit int32) ([]models.Like, error)
+	GetUsersByIds(userId int64, ids []int64) ([]models.GetMatchingUser, error)
+	GetSuperLikerIds(userId int64) ([]int64, error)
+	GetPreferences(userId int64) *models.UserPreferences
+	GetLikerIds(userId int64) ([]int64, error)
+	GetCandidate(userId int64) *models.GetMatchingUser
+	GetPoolViewers(userId int64, strict bool) ([]models.PoolViewer, error)
+	GetSwipedByIds(userId int64) ([]int64, error)
+	GetLikedIds(userId int64) ([]int64, error)
+	GetLocatedUsers() ([]models.UserLocation, error)
+	GetTravelChangedIds(since, until time.Time) ([]int64, error)
 }
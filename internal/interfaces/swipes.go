@@ -1,15 +1,44 @@
 package interfaces
 
-import "flame/internal/models"
+import (
+	"flame/internal/models"
+	"time"
+)
 
 type SwipesService interface {
-	CreateOrUpdate(UserId1, userId2 int64, isLike bool) error
+	CreateOrUpdate(UserId1, userId2 int64, kind models.SwipeKind) (*models.Match, error)
 	GetUnreadSwipes(userId int64) []int64
+	CountUnreadSwipes(userId int64) (int64, error)
+	GetMatches(userId int64) []models.Match
+	GetMatch(userId, matchId int64) (*models.Match, error)
+	Unmatch(userId, matchId int64) (int64, error)
+	IsMatched(userId1, userId2 int64) bool
+	Rewind(userId int64) (int64, error)
+	GetQuota(userId int64) (*models.Quota, *models.Quota, error)
+	BlockPair(userId, targetId int64) (*models.Match, error)
 }
 
 type SwipesRepository interface {
-	CreateOrUpdate(UserId1, userId2 int64, isLike bool) error
+	CreateOrUpdate(UserId1, userId2 int64, kind models.SwipeKind) error
 	GetUnreadSwipes(userId int64) []int64
+	CountUnreadSwipes(userId int64) (int64, error)
 	GetSwipeById(userId1, userId2 int64) *models.Swipe
 	RemoveSwipeFromRedis(candidateListKey string, userId int64) error
+	CreateMatch(userId1, userId2 int64) (*models.Match, error)
+	GetMatches(userId int64) []models.Match
+	GetMatch(matchId int64) *models.Match
+	GetMatchByUsers(userId1, userId2 int64) *models.Match
+	Unmatch(match *models.Match, initiatorId int64) error
+	IsUnmatched(userId1, userId2 int64) bool
+	GetLastSwipeEvent(swiperId int64) *models.SwipeEvent
+	HasMatch(userId1, userId2 int64) bool
+	Rewind(event *models.SwipeEvent) error
+	AddSwipeToRedis(candidateListKey string, userId int64) error
+	IncrSuperLikes(userId int64, day time.Time) (int64, error)
+	DecrSuperLikes(userId int64, day time.Time) error
+	GetSuperLikes(userId int64, day time.Time) (int64, error)
+	ReserveLike(userId, targetId int64, now time.Time, window time.Duration) (int64, time.Time, bool, error)
+	ReleaseLike(userId, targetId int64) error
+	GetLikesInWindow(userId int64, now time.Time, window time.Duration) (int64, time.Time, error)
+	CountLikesSince(userId int64, since time.Time) (int64, time.Time, error)
 }
@@ -2,8 +2,8 @@ package mappers
 
 import (
 	"flame/internal/models"
+	"flame/pkg/geo"
 	"flame/pkg/pb"
-	"github.com/umahmood/haversine"
 	"time"
 )
 
@@ -27,7 +27,9 @@ func FromModelPhotosToGrpc(photos []models.UserPhoto) []*pb.UserPhoto {
 	return res
 }
 
-func FromModelGetMatchingUserToGrpc(user models.GetMatchingUser, lonLat *models.LonLat) *pb.UserMatch {
+// FromModelGetMatchingUserToGrpc builds the card viewerId sees. The distance is never exact, see
+// geo.DisplayDistance, and is left out when either side has no location.
+func FromModelGetMatchingUserToGrpc(user models.GetMatchingUser, viewerId int64, lonLat *models.LonLat) *pb.UserMatch {
 	var age *int32
 	if user.BirthDate != nil {
 		birthTime, err := time.Parse(time.RFC3339, *user.BirthDate)
@@ -41,20 +43,28 @@ func FromModelGetMatchingUserToGrpc(user models.GetMatchingUser, lonLat *models.
 		}
 
 	}
-	var distance float64
-	p1 := haversine.Coord{Lat: lonLat.Lat, Lon: lonLat.Lon}
-	p2 := haversine.Coord{Lat: user.Lat, Lon: user.Lon}
-	_, distance = haversine.Distance(p1, p2)
+	var distance int32
+	var distanceLabel string
+	if lonLat != nil && !user.NoLocation {
+		distance, distanceLabel = geo.DisplayDistance(viewerId, user.Id,
+			geo.Point{Lon: lonLat.Lon, Lat: lonLat.Lat},
+			geo.Point{Lon: user.Lon, Lat: user.Lat},
+		)
+	}
 
 	if user.PhotoUrl == nil || *user.PhotoUrl == "" {
 		return &pb.UserMatch{
-			Id:       user.Id,
-			Name:     user.Name,
-			Age:      age,
-			City:     user.City,
-			Gender:   user.Gender,
-			Photo:    nil,
-			Distance: int32(distance * 1000),
+			Id:            user.Id,
+			Name:          user.Name,
+			Age:           age,
+			City:          user.City,
+			Gender:        user.Gender,
+			Photo:         nil,
+			Distance:      distance,
+			DistanceLabel: distanceLabel,
+			IsSuperLike:   user.IsSuperLike,
+			InterestedIn:  user.InterestedIn,
+			Visiting:      user.Visiting,
 		}
 	}
 	return &pb.UserMatch{
@@ -67,16 +77,73 @@ func FromModelGetMatchingUserToGrpc(user models.GetMatchingUser, lonLat *models.
 			Id:       *user.PhotoId,
 			PhotoUrl: *user.PhotoUrl,
 		},
-		Distance: int32(distance * 1000),
+		Distance:      distance,
+		DistanceLabel: distanceLabel,
+		IsSuperLike:   user.IsSuperLike,
+		InterestedIn:  user.InterestedIn,
+		Visiting:      user.Visiting,
 	}
 }
-func FromModelGetMatchingUsersToGrpc(users []models.GetMatchingUser, lonLat *models.LonLat) []*pb.UserMatch {
+func FromModelGetMatchingUsersToGrpc(users []models.GetMatchingUser, viewerId int64, lonLat *models.LonLat) []*pb.UserMatch {
 	res := make([]*pb.UserMatch, len(users))
 	if res == nil {
 		return nil
 	}
 	for i, u := range users {
-		res[i] = FromModelGetMatchingUserToGrpc(u, lonLat)
+		res[i] = FromModelGetMatchingUserToGrpc(u, viewerId, lonLat)
+	}
+	return res
+}
+
+func FromModelLikeCardsToGrpc(likes []models.LikeCard, viewerId int64, lonLat *models.LonLat) []*pb.LikeCard {
+	res := make([]*pb.LikeCard, len(likes))
+	for i, l := range likes {
+		res[i] = &pb.LikeCard{
+			User:        FromModelGetMatchingUserToGrpc(l.User, viewerId, lonLat),
+			LikedAt:     l.LikedAt.Format(time.RFC3339),
+			IsSuperLike: l.Kind == models.SwipeSuperLike,
+		}
 	}
 	return res
 }
+
+func FromModelReportsToGrpc(reports []models.Report) []*pb.Report {
+	res := make([]*pb.Report, len(reports))
+	for i, r := range reports {
+		res[i] = &pb.Report{
+			Id:         r.Id,
+			ReporterId: r.ReporterId,
+			ReportedId: r.ReportedId,
+			Reason:     r.Reason,
+			Comment:    r.Comment,
+			Status:     r.Status,
+			CreatedAt:  r.CreatedAt,
+		}
+	}
+	return res
+}
+
+func FromModelSanctionsToGrpc(sanctions []models.Sanction) []*pb.Sanction {
+	res := make([]*pb.Sanction, len(sanctions))
+	for i, s := range sanctions {
+		res[i] = &pb.Sanction{
+			Id:        s.Id,
+			AdminId:   s.AdminId,
+			Kind:      s.Kind,
+			Reason:    s.Reason,
+			ExpiresAt: s.ExpiresAt,
+			CreatedAt: s.CreatedAt,
+		}
+	}
+	return res
+}
+
+func FromModelTravelToGrpc(travel *models.Travel, now time.Time) *pb.Travel {
+	return &pb.Travel{
+		Lon:      travel.Lon,
+		Lat:      travel.Lat,
+		StartsAt: travel.StartsAt.UTC().Format(time.RFC3339),
+		EndsAt:   travel.EndsAt.UTC().Format(time.RFC3339),
+		IsActive: travel.IsActive(now),
+	}
+}
@@ -0,0 +1,43 @@
+package mappers
+
+import (
+	"flame/internal/models"
+	"github.com/go-playground/assert/v2"
+	"testing"
+)
+
+func TestFromModelGetMatchingUserToGrpc_Distance(t *testing.T) {
+	viewer := &models.LonLat{Lon: 37.6173, Lat: 55.7558}
+	tests := []struct {
+		name     string
+		user     models.GetMatchingUser
+		lonLat   *models.LonLat
+		distance bool
+	}{
+		{
+			name:     "both located",
+			user:     models.GetMatchingUser{User: models.User{Id: 2}, Lon: 30.3141, Lat: 59.9386},
+			lonLat:   viewer,
+			distance: true,
+		},
+		{
+			name:     "user without a location",
+			user:     models.GetMatchingUser{User: models.User{Id: 2}, NoLocation: true},
+			lonLat:   viewer,
+			distance: false,
+		},
+		{
+			name:     "viewer without a location",
+			user:     models.GetMatchingUser{User: models.User{Id: 2}, Lon: 30.3141, Lat: 59.9386},
+			lonLat:   nil,
+			distance: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := FromModelGetMatchingUserToGrpc(tt.user, 1, tt.lonLat)
+			assert.Equal(t, res.Distance != 0, tt.distance)
+			assert.Equal(t, res.DistanceLabel != "", tt.distance)
+		})
+	}
+}
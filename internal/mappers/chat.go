@@ -0,0 +1,38 @@
+package mappers
+
+import (
+	"flame/internal/models"
+	"flame/pkg/pb"
+)
+
+func FromModelMessageToGrpc(message models.Message) *pb.ChatMessage {
+	return &pb.ChatMessage{
+		Id:             message.Id,
+		ConversationId: message.ConversationId,
+		SenderId:       message.SenderId,
+		Text:           message.Text,
+		CreatedAt:      message.CreatedAt,
+		ReadAt:         message.ReadAt,
+	}
+}
+
+func FromModelMessagesToGrpc(messages []models.Message) []*pb.ChatMessage {
+	res := make([]*pb.ChatMessage, len(messages))
+	for i, m := range messages {
+		res[i] = FromModelMessageToGrpc(m)
+	}
+	return res
+}
+
+func FromModelConversationsToGrpc(conversations []models.Conversation, userId int64) []*pb.Conversation {
+	res := make([]*pb.Conversation, len(conversations))
+	for i, c := range conversations {
+		res[i] = &pb.Conversation{
+			Id:        c.Id,
+			UserId:    c.Partner(userId),
+			UpdatedAt: c.UpdatedAt,
+			Unread:    c.Unread,
+		}
+	}
+	return res
+}
@@ -3,6 +3,7 @@ package mappers
 import (
 	"flame/internal/models"
 	"strconv"
+	"strings"
 )
 
 func FromModelToMapMatchingUser(user models.GetMatchingUser) map[string]interface{} {
@@ -28,15 +29,17 @@ func FromModelToMapMatchingUser(user models.GetMatchingUser) map[string]interfac
 		city = *user.City
 	}
 	return map[string]interface{}{
-		"id":         user.Id,
-		"name":       user.Name,
-		"birth_date": birthdate,
-		"photo_id":   photoId,
-		"photo_url":  photoUrl,
-		"gender":     gender,
-		"city":       city,
-		"lon":        user.Lon,
-		"lat":        user.Lat,
+		"id":            user.Id,
+		"name":          user.Name,
+		"birth_date":    birthdate,
+		"photo_id":      photoId,
+		"photo_url":     photoUrl,
+		"gender":        gender,
+		"city":          city,
+		"lon":           user.Lon,
+		"lat":           user.Lat,
+		"interested_in": strings.Join(user.InterestedIn, ","),
+		"visiting":      strconv.FormatBool(user.Visiting),
 	}
 }
 
@@ -77,6 +80,13 @@ func FromMapToModelMatchingUser(user map[string]string) models.GetMatchingUser {
 	latStr := user["lat"]
 	lat, _ := strconv.ParseFloat(latStr, 64)
 
+	var interestedIn []string
+	if user["interested_in"] != "" {
+		interestedIn = strings.Split(user["interested_in"], ",")
+	}
+
+	visiting, _ := strconv.ParseBool(user["visiting"])
+
 	return models.GetMatchingUser{
 		User: models.User{
 			Id:        id,
@@ -85,9 +95,11 @@ func FromMapToModelMatchingUser(user map[string]string) models.GetMatchingUser {
 			Gender:    gender,
 			City:      city,
 		},
-		PhotoId:  photoId,
-		PhotoUrl: &photoUrl,
-		Lat:      lat,
-		Lon:      lon,
+		PhotoId:      photoId,
+		PhotoUrl:     &photoUrl,
+		Lat:          lat,
+		Lon:          lon,
+		InterestedIn: interestedIn,
+		Visiting:     visiting,
 	}
 }
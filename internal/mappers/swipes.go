@@ -0,0 +1,75 @@
+package mappers
+
+import (
+	"flame/internal/models"
+	"flame/pkg/pb"
+	"time"
+)
+
+func FromModelMatchToGrpc(match models.Match, userId int64) *pb.Match {
+	return &pb.Match{
+		Id:        match.Id,
+		UserId:    match.Partner(userId),
+		CreatedAt: match.CreatedAt,
+	}
+}
+
+func FromModelMatchesToGrpc(matches []models.Match, userId int64) []*pb.Match {
+	res := make([]*pb.Match, len(matches))
+	for i, m := range matches {
+		res[i] = FromModelMatchToGrpc(m, userId)
+	}
+	return res
+}
+
+// FromGrpcSwipeKindToModel returns an empty kind for SWIPE_KIND_UNSPECIFIED, the service rejects
+// it.
+func FromGrpcSwipeKindToModel(kind pb.SwipeKind) models.SwipeKind {
+	switch kind {
+	case pb.SwipeKind_SWIPE_KIND_SUPER_LIKE:
+		return models.SwipeSuperLike
+	case pb.SwipeKind_SWIPE_KIND_LIKE:
+		return models.SwipeLike
+	case pb.SwipeKind_SWIPE_KIND_DISLIKE:
+		return models.SwipeDislike
+	default:
+		return ""
+	}
+}
+
+// FromGrpcSwipeReqToKind reads the kind of a swipe, falling back to the deprecated IsLike field
+// for clients that do not send Kind yet.
+func FromGrpcSwipeReqToKind(r *pb.CreateOrUpdateSwipeReq) models.SwipeKind {
+	if r.Kind == pb.SwipeKind_SWIPE_KIND_UNSPECIFIED && r.IsLike != nil {
+		if *r.IsLike {
+			return models.SwipeLike
+		}
+		return models.SwipeDislike
+	}
+	return FromGrpcSwipeKindToModel(r.Kind)
+}
+
+func FromModelSwipeKindToGrpc(kind models.SwipeKind) pb.SwipeKind {
+	switch kind {
+	case models.SwipeSuperLike:
+		return pb.SwipeKind_SWIPE_KIND_SUPER_LIKE
+	case models.SwipeLike:
+		return pb.SwipeKind_SWIPE_KIND_LIKE
+	default:
+		return pb.SwipeKind_SWIPE_KIND_DISLIKE
+	}
+}
+
+func FromModelQuotaToGrpc(quota *models.Quota) *pb.Quota {
+	var resetAt *string
+	if quota.ResetAt != nil {
+		r := quota.ResetAt.UTC().Format(time.RFC3339)
+		resetAt = &r
+	}
+	return &pb.Quota{
+		Limit:     quota.Limit,
+		Used:      quota.Used,
+		Remaining: quota.Remaining(),
+		ResetAt:   resetAt,
+	}
+}
@@ -1,24 +1,80 @@
 package models
 
+import (
+	"github.com/lib/pq"
+	"time"
+)
+
 type Gender string
 
 const (
-	Male   Gender = "male"
-	Female Gender = "female"
+	Male        Gender = "male"
+	Female      Gender = "female"
+	NonBinary   Gender = "non_binary"
+	Genderqueer Gender = "genderqueer"
+	Genderfluid Gender = "genderfluid"
+	Agender     Gender = "agender"
+	OtherGender Gender = "other"
 )
 
 type User struct {
-	Id        int64   `db:"id"`
-	CreatedAt string  `db:"created_at"`
-	UpdatedAt string  `db:"updated_at"`
-	Email     string  `db:"email"`
-	Password  string  `db:"password"`
-	BirthDate *string `db:"birth_date"`
-	City      *string `db:"city"`
-	Gender    *string `db:"gender"`
-	Name      string  `db:"name"`
-	Bio       *string `db:"bio"`
-	Location  *string `db:"location"`
+	Id             int64      `db:"id"`
+	CreatedAt      string     `db:"created_at"`
+	UpdatedAt      string     `db:"updated_at"`
+	Email          string     `db:"email"`
+	Password       string     `db:"password"`
+	BirthDate      *string    `db:"birth_date"`
+	City           *string    `db:"city"`
+	Gender         *string    `db:"gender"`
+	Name           string     `db:"name"`
+	Bio            *string    `db:"bio"`
+	Location       *string    `db:"location"`
+	Role           string     `db:"role"`
+	Status         string     `db:"status"`
+	SuspendedUntil *time.Time `db:"suspended_until"`
+	LastActiveAt   *time.Time `db:"last_active_at"`
+	TravelLocation *string    `db:"travel_location"`
+	TravelStartsAt *time.Time `db:"travel_starts_at"`
+	TravelEndsAt   *time.Time `db:"travel_ends_at"`
+}
+
+type UserStatus string
+
+const (
+	StatusActive    UserStatus = "active"
+	StatusSuspended UserStatus = "suspended"
+	StatusBanned    UserStatus = "banned"
+)
+
+// CurrentStatus treats a suspension that has already ended as active.
+func (user *User) CurrentStatus(now time.Time) UserStatus {
+	switch UserStatus(user.Status) {
+	case StatusBanned:
+		return StatusBanned
+	case StatusSuspended:
+		if user.SuspendedUntil != nil && user.SuspendedUntil.After(now) {
+			return StatusSuspended
+		}
+	}
+	return StatusActive
+}
+
+// IsTravelling reports whether the user is matched from their travel location at now.
+func (user *User) IsTravelling(now time.Time) bool {
+	return user.TravelLocation != nil && user.TravelStartsAt != nil && user.TravelEndsAt != nil &&
+		!user.TravelStartsAt.After(now) && now.Before(*user.TravelEndsAt)
+}
+
+// Travel is a temporary location the user is matched from instead of their real one.
+type Travel struct {
+	Lon      float64   `db:"lon"`
+	Lat      float64   `db:"lat"`
+	StartsAt time.Time `db:"travel_starts_at"`
+	EndsAt   time.Time `db:"travel_ends_at"`
+}
+
+func (travel *Travel) IsActive(now time.Time) bool {
+	return !travel.StartsAt.After(now) && now.Before(travel.EndsAt)
 }
 
 type UserPhoto struct {
@@ -31,25 +87,93 @@ type UserPhoto struct {
 
 type GetMatchingUser struct {
 	User
-	PhotoId  *int64  `db:"photo_id"`
-	PhotoUrl *string `db:"photo_url"`
-	Lon      float64 `db:"lon"`
-	Lat      float64 `db:"lat"`
+	PhotoId      *int64         `db:"photo_id"`
+	PhotoUrl     *string        `db:"photo_url"`
+	Lon          float64        `db:"lon"`
+	Lat          float64        `db:"lat"`
+	InterestedIn pq.StringArray `db:"interested_in"`
+	Mismatches   int            `db:"mismatches"`
+	Visiting     bool           `db:"visiting"`
+	NoLocation   bool           `db:"no_location"`
+	IsSuperLike  bool           `db:"-"`
+	Score        float64        `db:"-"`
 }
 
 type UserPreferences struct {
 	UserId   int64   `db:"user_id"`
 	Distance *int32  `db:"distance"`
-	Age      *int32  `db:"age"`
-	Gender   *string `db:"gender"`
+	AgeMin   *int32  `db:"age_min"`
+	AgeMax   *int32  `db:"age_max"`
 	City     *string `db:"city"`
 }
 
 func GenderIsValid(str string) bool {
-	switch str {
-	case string(Male):
+	switch Gender(str) {
+	case Male, Female, NonBinary, Genderqueer, Genderfluid, Agender, OtherGender:
 		return true
-	case string(Female):
+	default:
+		return false
+	}
+}
+
+type Role string
+
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
+type ReportReason string
+
+const (
+	Spam          ReportReason = "spam"
+	Fake          ReportReason = "fake"
+	Inappropriate ReportReason = "inappropriate"
+	Harassment    ReportReason = "harassment"
+	Underage      ReportReason = "underage"
+	Other         ReportReason = "other"
+)
+
+type ReportStatus string
+
+const (
+	ReportOpen      ReportStatus = "open"
+	ReportResolved  ReportStatus = "resolved"
+	ReportDismissed ReportStatus = "dismissed"
+)
+
+type Report struct {
+	Id         int64   `db:"id"`
+	ReporterId int64   `db:"reporter_id"`
+	ReportedId int64   `db:"reported_id"`
+	Reason     string  `db:"reason"`
+	Comment    *string `db:"comment"`
+	CreatedAt  string  `db:"created_at"`
+	Status     string  `db:"status"`
+	ResolvedBy *int64  `db:"resolved_by"`
+	ResolvedAt *string `db:"resolved_at"`
+}
+
+type SanctionKind string
+
+const (
+	Suspend SanctionKind = "suspend"
+	Ban     SanctionKind = "ban"
+)
+
+type Sanction struct {
+	Id        int64   `db:"id"`
+	UserId    int64   `db:"user_id"`
+	AdminId   *int64  `db:"admin_id"`
+	Kind      string  `db:"kind"`
+	Reason    string  `db:"reason"`
+	ExpiresAt *string `db:"expires_at"`
+	CreatedAt string  `db:"created_at"`
+}
+
+func ReportReasonIsValid(str string) bool {
+	switch ReportReason(str) {
+	case Spam, Fake, Inappropriate, Harassment, Underage, Other:
 		return true
 	default:
 		return false
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"github.com/go-playground/assert/v2"
+	"testing"
+	"time"
+)
+
+func TestUser_IsTravelling(t *testing.T) {
+	now := time.Date(2025, 5, 23, 12, 0, 0, 0, time.UTC)
+	location := "(2.35 48.86)"
+	at := func(d time.Duration) *time.Time {
+		res := now.Add(d)
+		return &res
+	}
+	tests := []struct {
+		name string
+		user User
+		res  bool
+	}{
+		{
+			name: "no travel",
+			user: User{},
+			res:  false,
+		},
+		{
+			name: "during the trip",
+			user: User{TravelLocation: &location, TravelStartsAt: at(-time.Hour), TravelEndsAt: at(time.Hour)},
+			res:  true,
+		},
+		{
+			name: "starts now",
+			user: User{TravelLocation: &location, TravelStartsAt: at(0), TravelEndsAt: at(time.Hour)},
+			res:  true,
+		},
+		{
+			name: "ends now",
+			user: User{TravelLocation: &location, TravelStartsAt: at(-time.Hour), TravelEndsAt: at(0)},
+			res:  false,
+		},
+		{
+			name: "not started",
+			user: User{TravelLocation: &location, TravelStartsAt: at(time.Hour), TravelEndsAt: at(2 * time.Hour)},
+			res:  false,
+		},
+		{
+			name: "ended",
+			user: User{TravelLocation: &location, TravelStartsAt: at(-2 * time.Hour), TravelEndsAt: at(-time.Hour)},
+			res:  false,
+		},
+		{
+			name: "dates without a location",
+			user: User{TravelStartsAt: at(-time.Hour), TravelEndsAt: at(time.Hour)},
+			res:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.user.IsTravelling(now), tt.res)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package models
+
+type Conversation struct {
+	Id        int64  `db:"id"`
+	UserId1   int64  `db:"user_id1"`
+	UserId2   int64  `db:"user_id2"`
+	CreatedAt string `db:"created_at"`
+	UpdatedAt string `db:"updated_at"`
+	Unread    int64  `db:"unread"`
+}
+
+func (conversation *Conversation) Partner(userId int64) int64 {
+	if conversation.UserId1 == userId {
+		return conversation.UserId2
+	}
+	return conversation.UserId1
+}
+
+type Message struct {
+	Id             int64   `db:"id"`
+	ConversationId int64   `db:"conversation_id"`
+	SenderId       int64   `db:"sender_id"`
+	Text           string  `db:"text"`
+	CreatedAt      string  `db:"created_at"`
+	ReadAt         *string `db:"read_at"`
+}
@@ -1,6 +1,39 @@
 package models
 
+import "time"
+
 type LonLat struct {
 	Lon float64 `db:"lon"'`
 	Lat float64 `db:"lat"'`
 }
+
+type Like struct {
+	UserId  int64     `db:"user_id"`
+	LikedAt time.Time `db:"liked_at"`
+	Kind    SwipeKind `db:"kind"`
+}
+
+type LikeCard struct {
+	User    GetMatchingUser
+	LikedAt time.Time
+	Kind    SwipeKind
+}
+
+// PoolViewer is a user whose candidate pool may contain another user, with the preferences
+// needed to score that user for them.
+type PoolViewer struct {
+	Id         int64   `db:"id"`
+	Lon        float64 `db:"lon"`
+	Lat        float64 `db:"lat"`
+	Distance   *int32  `db:"distance"`
+	AgeMin     *int32  `db:"age_min"`
+	AgeMax     *int32  `db:"age_max"`
+	City       *string `db:"city"`
+	Mismatches int     `db:"mismatches"`
+}
+
+type UserLocation struct {
+	Id  int64   `db:"id"`
+	Lon float64 `db:"lon"`
+	Lat float64 `db:"lat"`
+}
@@ -1,8 +1,80 @@
 package models
 
+import "time"
+
+type SwipeKind string
+
+const (
+	SwipeDislike   SwipeKind = "dislike"
+	SwipeLike      SwipeKind = "like"
+	SwipeSuperLike SwipeKind = "super_like"
+)
+
+func (kind SwipeKind) IsValid() bool {
+	return kind == SwipeDislike || kind == SwipeLike || kind == SwipeSuperLike
+}
+
+func (kind SwipeKind) IsLike() bool {
+	return kind == SwipeLike || kind == SwipeSuperLike
+}
+
 type Swipe struct {
-	UserId1      int64 `db:"user_id1"`
-	UserId2      int64 `db:"user_id2"`
-	UserIsLiked1 *bool `db:"user_is_liked1"`
-	UserIsLiked2 *bool `db:"user_is_liked2"`
+	UserId1       int64   `db:"user_id1"`
+	UserId2       int64   `db:"user_id2"`
+	UserIsLiked1  *bool   `db:"user_is_liked1"`
+	UserIsLiked2  *bool   `db:"user_is_liked2"`
+	LikedAt1      *string `db:"liked_at1"`
+	LikedAt2      *string `db:"liked_at2"`
+	Kind1         *string `db:"kind1"`
+	Kind2         *string `db:"kind2"`
+	FirstSwiperId *int64  `db:"first_swiper_id"`
+	FirstSwipedAt *string `db:"first_swiped_at"`
+	CreatedAt     string  `db:"created_at"`
+	UpdatedAt     string  `db:"updated_at"`
+}
+
+type SwipeEvent struct {
+	Id        int64      `db:"id"`
+	SwiperId  int64      `db:"swiper_id"`
+	TargetId  int64      `db:"target_id"`
+	IsLike    bool       `db:"is_like"`
+	Kind      SwipeKind  `db:"kind"`
+	CreatedAt time.Time  `db:"created_at"`
+	RewoundAt *time.Time `db:"rewound_at"`
+}
+
+func (swipe *Swipe) IsMutual() bool {
+	return swipe.UserIsLiked1 != nil && *swipe.UserIsLiked1 &&
+		swipe.UserIsLiked2 != nil && *swipe.UserIsLiked2
+}
+
+type Match struct {
+	Id        int64  `db:"id"`
+	UserId1   int64  `db:"user_id1"`
+	UserId2   int64  `db:"user_id2"`
+	CreatedAt string `db:"created_at"`
+}
+
+func (match *Match) Partner(userId int64) int64 {
+	if match.UserId1 == userId {
+		return match.UserId2
+	}
+	return match.UserId1
+}
+
+func (match *Match) Has(userId int64) bool {
+	return match.UserId1 == userId || match.UserId2 == userId
+}
+
+type Quota struct {
+	Limit   int64
+	Used    int64
+	ResetAt *time.Time
+}
+
+func (quota Quota) Remaining() int64 {
+	if quota.Used >= quota.Limit {
+		return 0
+	}
+	return quota.Limit - quota.Used
 }
@@ -1,8 +1,10 @@
 package account
 
 import (
+	"context"
 	"flame/internal/config"
 	"flame/pkg/db"
+	"flame/pkg/events"
 	"flame/pkg/pb"
 	"google.golang.org/grpc"
 	"log/slog"
@@ -53,12 +55,26 @@ func (app *App) Run() error {
 	service := NewService(&ServiceDeps{
 		Repository: repository,
 		Logger:     app.Logger,
+		Config:     app.Config,
 	})
 	handler := NewHandler(&HandlerDeps{
 		Logger:  app.Logger,
 		Config:  app.Config,
 		Service: service,
+		Redis:   app.Redis,
 	})
+	relay := events.NewRelay(&events.RelayDeps{
+		DB:        app.Db,
+		Redis:     app.Redis,
+		Logger:    app.Logger,
+		Interval:  app.Config.Events.RelayInterval,
+		BatchSize: app.Config.Events.RelayBatchSize,
+		MaxLen:    app.Config.Events.StreamMaxLen,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go relay.Run(ctx)
+
 	server := grpc.NewServer(opts...)
 	defer server.Stop()
 	pb.RegisterAccountServer(server, handler)
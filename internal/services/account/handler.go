@@ -4,11 +4,15 @@ import (
 	"context"
 	"flame/internal/config"
 	"flame/internal/interfaces"
+	"flame/internal/mappers"
+	"flame/internal/models"
+	"flame/pkg/db"
 	grpc_conn "flame/pkg/grpc-conn"
 	"flame/pkg/jwt"
 	"flame/pkg/pb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"log/slog"
+	"time"
 )
 
 type Handler struct {
@@ -16,6 +20,8 @@ type Handler struct {
 	Config         *config.Config
 	Service        interfaces.AccountService
 	MatchingClient pb.MatchingClient
+	SwipesClient   pb.SwipesClient
+	Rebuilder      *Rebuilder
 	pb.UnsafeAccountServer
 }
 
@@ -23,6 +29,7 @@ type HandlerDeps struct {
 	Logger  *slog.Logger
 	Config  *config.Config
 	Service interfaces.AccountService
+	Redis   *db.Redis
 }
 
 func NewHandler(deps *HandlerDeps) *Handler {
@@ -35,12 +42,28 @@ func NewHandler(deps *HandlerDeps) *Handler {
 		return nil
 	}
 	matchClient := pb.NewMatchingClient(matchConn)
+	swipesConn, err := grpc_conn.NewClientConn(deps.Config.Services.Swipes.Address)
+	if err != nil {
+		deps.Logger.Error(err.Error(),
+			slog.String("Error location", "NewAccountHandler.grpc_conn.NewClientConn"),
+			slog.String("Swipes address", deps.Config.Services.Swipes.Address),
+		)
+		return nil
+	}
+	swipesClient := pb.NewSwipesClient(swipesConn)
 
 	return &Handler{
 		Logger:         deps.Logger,
 		Config:         deps.Config,
 		Service:        deps.Service,
 		MatchingClient: matchClient,
+		SwipesClient:   swipesClient,
+		Rebuilder: NewRebuilder(&RebuilderDeps{
+			Logger:         deps.Logger,
+			MatchingClient: matchClient,
+			Redis:          deps.Redis,
+			Delay:          deps.Config.Matching.RebuildDebounce,
+		}),
 	}
 }
 
@@ -55,7 +78,8 @@ func (handler *Handler) Register(ctx context.Context, r *pb.RegisterReq) (*pb.Re
 		return nil, err
 	}
 	tokens, err := handler.Service.IssueToken(handler.Config.Auth.Jwt, jwt.Data{
-		Id: id,
+		Id:   id,
+		Role: string(models.RoleUser),
 	})
 	if err != nil {
 		return nil, err
@@ -70,7 +94,7 @@ func (handler *Handler) Login(ctx context.Context, r *pb.LoginReq) (*pb.LoginRes
 	if err != nil {
 		return nil, err
 	}
-	tokens, err := handler.Service.IssueToken(handler.Config.Auth.Jwt, jwt.Data{
+	tokens, err := handler.Service.GetTokens(handler.Config.Auth.Jwt, jwt.Data{
 		Id: id,
 	})
 	if err != nil {
@@ -127,10 +151,13 @@ func (handler *Handler) DeletePhoto(ctx context.Context, r *pb.DeletePhotoReq) (
 }
 
 func (handler *Handler) UpdateLocation(ctx context.Context, r *pb.UpdateLocationReq) (*pb.UpdateLocationRes, error) {
-	err := handler.Service.UpdateLocation(r.UserId, r.Location)
+	moved, err := handler.Service.UpdateLocation(r.UserId, r.Location)
 	if err != nil {
 		return nil, err
 	}
+	if moved {
+		handler.Rebuilder.Schedule(r.UserId)
+	}
 	return &pb.UpdateLocationRes{}, nil
 }
 
@@ -139,6 +166,128 @@ func (handler *Handler) UpdatePreferences(ctx context.Context, r *pb.UpdatePrefe
 	if err != nil {
 		return &emptypb.Empty{}, err
 	}
-	_, err = handler.MatchingClient.UpdateRedis(ctx, &pb.UpdateRedisReq{UserId: r.UserId})
+	handler.Rebuilder.Schedule(r.UserId)
+	return &emptypb.Empty{}, nil
+}
+
+func (handler *Handler) Block(ctx context.Context, r *pb.BlockReq) (*emptypb.Empty, error) {
+	err := handler.Service.Block(r.UserId, r.TargetId)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+	// The block is saved but the pair would still be matched, the error lets the client retry,
+	// blocking again is a no-op.
+	_, err = handler.SwipesClient.BlockPair(ctx, &pb.BlockPairReq{
+		UserId:   r.UserId,
+		TargetId: r.TargetId,
+	})
+	if err != nil {
+		handler.Logger.Error(err.Error(),
+			slog.String("Error location", "handler.SwipesClient.BlockPair"),
+			slog.Int64("UserId", r.UserId),
+			slog.Int64("TargetId", r.TargetId),
+		)
+		return &emptypb.Empty{}, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (handler *Handler) Report(ctx context.Context, r *pb.ReportReq) (*emptypb.Empty, error) {
+	err := handler.Service.Report(&models.Report{
+		ReporterId: r.UserId,
+		ReportedId: r.TargetId,
+		Reason:     r.Reason,
+		Comment:    r.Comment,
+	})
+	return &emptypb.Empty{}, err
+}
+
+func (handler *Handler) IsBlocked(ctx context.Context, r *pb.IsBlockedReq) (*pb.IsBlockedRes, error) {
+	isBlocked, err := handler.Service.IsBlocked(r.UserId1, r.UserId2)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IsBlockedRes{
+		IsBlocked: isBlocked,
+	}, nil
+}
+
+func (handler *Handler) GetReports(ctx context.Context, r *pb.GetReportsReq) (*pb.GetReportsRes, error) {
+	reports, nextCursor, err := handler.Service.GetReports(r.AdminId, r.Cursor, r.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetReportsRes{
+		Reports:    mappers.FromModelReportsToGrpc(reports),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (handler *Handler) ResolveReport(ctx context.Context, r *pb.ResolveReportReq) (*emptypb.Empty, error) {
+	err := handler.Service.ResolveReport(r.AdminId, r.ReportId, r.Status)
 	return &emptypb.Empty{}, err
 }
+
+func (handler *Handler) GetReportedUser(ctx context.Context, r *pb.GetReportedUserReq) (*pb.GetReportedUserRes, error) {
+	return handler.Service.GetReportedUser(r.AdminId, r.UserId)
+}
+
+func (handler *Handler) SanctionUser(ctx context.Context, r *pb.SanctionUserReq) (*emptypb.Empty, error) {
+	err := handler.Service.SanctionUser(r.AdminId, &models.Sanction{
+		UserId:    r.UserId,
+		Kind:      r.Kind,
+		Reason:    r.Reason,
+		ExpiresAt: r.ExpiresAt,
+	})
+	return &emptypb.Empty{}, err
+}
+
+func (handler *Handler) GetStatus(ctx context.Context, r *pb.GetStatusReq) (*pb.GetStatusRes, error) {
+	userStatus, err := handler.Service.GetStatus(r.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetStatusRes{
+		Status: string(userStatus),
+	}, nil
+}
+
+func (handler *Handler) RemovePhoto(ctx context.Context, r *pb.RemovePhotoReq) (*pb.RemovePhotoRes, error) {
+	url, err := handler.Service.RemovePhoto(r.AdminId, r.PhotoId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemovePhotoRes{
+		PhotoUrl: url,
+	}, nil
+}
+
+func (handler *Handler) SetTravel(ctx context.Context, r *pb.SetTravelReq) (*emptypb.Empty, error) {
+	err := handler.Service.SetTravel(r.UserId, r.Location, r.StartsAt, r.EndsAt)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+	handler.Rebuilder.Schedule(r.UserId)
+	return &emptypb.Empty{}, nil
+}
+
+func (handler *Handler) GetTravel(ctx context.Context, r *pb.GetTravelReq) (*pb.GetTravelRes, error) {
+	travel := handler.Service.GetTravel(r.UserId)
+	if travel == nil {
+		return &pb.GetTravelRes{}, nil
+	}
+	return &pb.GetTravelRes{
+		Travel: mappers.FromModelTravelToGrpc(travel, time.Now()),
+	}, nil
+}
+
+func (handler *Handler) EndTravel(ctx context.Context, r *pb.EndTravelReq) (*emptypb.Empty, error) {
+	ended, err := handler.Service.EndTravel(r.UserId)
+	if err != nil {
+		return &emptypb.Empty{}, err
+	}
+	if ended {
+		handler.Rebuilder.Schedule(r.UserId)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-playground/assert/v2"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"net/http"
@@ -36,11 +37,9 @@ func TestHandler_Register(t *testing.T) {
 		isErr bool
 	}
 	validData := &pb.RegisterReq{
-		Email:      "test@gmail.com",
-		Password:   "123456",
-		Name:       "test",
-		Gender:     "male",
-		LookingFor: "female",
+		Email:    "test@gmail.com",
+		Password: "123456",
+		Name:     "test",
 	}
 	tests := []struct {
 		name    string
@@ -146,8 +145,8 @@ func TestHandler_Login(t *testing.T) {
 				isErr: false,
 			},
 			service: func() {
-				service.On("Login", mock.Anything, mock.Anything).Return(1, nil)
-				service.On("IssueToken", mock.Anything, mock.Anything).Return(&interfaces.AccountSIssueToken{
+				service.On("Login", mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+				service.On("GetTokens", mock.Anything, mock.Anything).Return(&interfaces.AccountSIssueToken{
 					AccessToken:  accessToken,
 					RefreshToken: refreshToken,
 				}, nil)
@@ -161,9 +160,9 @@ func TestHandler_Login(t *testing.T) {
 				isErr: true,
 			},
 			service: func() {
-				service.On("Login", mock.Anything, mock.Anything).
+				service.On("Login", mock.Anything, mock.Anything, mock.Anything).
 					Return(-1, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError)))
-				service.On("IssueToken", mock.Anything, mock.Anything).
+				service.On("GetTokens", mock.Anything, mock.Anything).
 					Return(&interfaces.AccountSIssueToken{
 						AccessToken:  accessToken,
 						RefreshToken: refreshToken,
@@ -178,8 +177,8 @@ func TestHandler_Login(t *testing.T) {
 				isErr: true,
 			},
 			service: func() {
-				service.On("Login", mock.Anything, mock.Anything).Return(1, nil)
-				service.On("IssueToken", mock.Anything, mock.Anything).
+				service.On("Login", mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+				service.On("GetTokens", mock.Anything, mock.Anything).
 					Return(nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError)))
 			},
 		},
@@ -263,3 +262,75 @@ func TestHandler_GetTokens(t *testing.T) {
 		})
 	}
 }
+
+type blockPairClient struct {
+	pb.SwipesClient
+	err   error
+	calls int
+}
+
+func (client *blockPairClient) BlockPair(ctx context.Context, in *pb.BlockPairReq, opts ...grpc.CallOption) (*pb.BlockPairRes, error) {
+	client.calls++
+	if client.err != nil {
+		return nil, client.err
+	}
+	return &pb.BlockPairRes{}, nil
+}
+
+func TestHandler_Block(t *testing.T) {
+	log := logger.NewLogger(os.Stdout)
+	conf := config.LoadConfig(configPath, mode)
+	service := new(mocks.MockAccountService)
+	handler := NewHandler(&HandlerDeps{
+		Logger:  log,
+		Config:  conf,
+		Service: service,
+	})
+	tests := []struct {
+		name    string
+		service func()
+		swipes  *blockPairClient
+		code    codes.Code
+		calls   int
+	}{
+		{
+			name: "success",
+			service: func() {
+				service.On("Block", int64(1), int64(2)).Return(nil)
+			},
+			swipes: &blockPairClient{},
+			code:   codes.OK,
+			calls:  1,
+		},
+		{
+			name: "bad service block",
+			service: func() {
+				service.On("Block", int64(1), int64(2)).Return(status.Errorf(codes.InvalidArgument, ""))
+			},
+			swipes: &blockPairClient{},
+			code:   codes.InvalidArgument,
+			calls:  0,
+		},
+		{
+			name: "bad swipes block pair",
+			service: func() {
+				service.On("Block", int64(1), int64(2)).Return(nil)
+			},
+			swipes: &blockPairClient{err: status.Errorf(codes.Unavailable, "")},
+			code:   codes.Unavailable,
+			calls:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.service()
+			t.Cleanup(func() {
+				service.ExpectedCalls = nil
+			})
+			handler.SwipesClient = tt.swipes
+			_, err := handler.Block(context.Background(), &pb.BlockReq{UserId: 1, TargetId: 2})
+			assert.Equal(t, status.Code(err), tt.code)
+			assert.Equal(t, tt.swipes.calls, tt.calls)
+		})
+	}
+}
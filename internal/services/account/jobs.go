@@ -0,0 +1,110 @@
+package account
+
+import (
+	"context"
+	"flame/pkg/scheduler"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// Used when the config leaves them at zero, which would delete photos uploaded a moment ago and
+// events the relay has not published yet.
+const (
+	defaultOrphanPhotoAge  = 24 * time.Hour
+	defaultOutboxRetention = 7 * 24 * time.Hour
+)
+
+type JobsDeps struct {
+	Repository      *Repository
+	Logger          *slog.Logger
+	S3Client        *s3.Client
+	Bucket          string
+	OrphanPhotoAge  time.Duration
+	OutboxRetention time.Duration
+}
+
+func NewJobs(deps *JobsDeps) []scheduler.Job {
+	orphanPhotoAge := deps.OrphanPhotoAge
+	if orphanPhotoAge <= 0 {
+		orphanPhotoAge = defaultOrphanPhotoAge
+	}
+	outboxRetention := deps.OutboxRetention
+	if outboxRetention <= 0 {
+		outboxRetention = defaultOutboxRetention
+	}
+	return []scheduler.Job{
+		{
+			Name:     "account.clean_orphan_photos",
+			Schedule: scheduler.MustCron("0 4 * * *"),
+			Timeout:  30 * time.Minute,
+			Retries:  2,
+			Run: func(ctx context.Context) error {
+				removed, err := cleanOrphanPhotos(ctx, deps, orphanPhotoAge)
+				if err != nil {
+					return err
+				}
+				deps.Logger.Info("Orphan photos removed", slog.Int("Removed", removed))
+				return nil
+			},
+		},
+		{
+			Name:     "account.purge_outbox",
+			Schedule: scheduler.MustCron("0 3 * * *"),
+			Retries:  2,
+			Run: func(ctx context.Context) error {
+				removed, err := deps.Repository.PurgeOutbox(time.Now().Add(-outboxRetention))
+				if err != nil {
+					return err
+				}
+				deps.Logger.Info("Outbox purged", slog.String("Service", "Account"), slog.Int64("Removed", removed))
+				return nil
+			},
+		},
+	}
+}
+
+// cleanOrphanPhotos deletes objects of the bucket that no user photo points to: uploads whose
+// UploadPhoto call failed and photos whose row was removed without the object. Objects newer
+// than maxAge are kept, their row may not be written yet.
+func cleanOrphanPhotos(ctx context.Context, deps *JobsDeps, maxAge time.Duration) (int, error) {
+	urls, err := deps.Repository.GetPhotoUrls()
+	if err != nil {
+		return 0, err
+	}
+	used := make(map[string]struct{}, len(urls))
+	for _, url := range urls {
+		filename := strings.Split(url, "/")
+		used[filename[len(filename)-1]] = struct{}{}
+	}
+	olderThan := time.Now().Add(-maxAge)
+	removed := 0
+	paginator := s3.NewListObjectsV2Paginator(deps.S3Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(deps.Bucket),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return removed, err
+		}
+		for _, object := range page.Contents {
+			if object.Key == nil || object.LastModified == nil || object.LastModified.After(olderThan) {
+				continue
+			}
+			if _, ok := used[*object.Key]; ok {
+				continue
+			}
+			_, err = deps.S3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(deps.Bucket),
+				Key:    object.Key,
+			})
+			if err != nil {
+				return removed, err
+			}
+			removed++
+		}
+	}
+	return removed, nil
+}
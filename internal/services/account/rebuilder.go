@@ -0,0 +1,156 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"flame/pkg/db"
+	"flame/pkg/pb"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRebuildDelay = 5 * time.Second
+	rebuildTimeout      = 10 * time.Second
+)
+
+type RebuilderDeps struct {
+	Logger         *slog.Logger
+	MatchingClient pb.MatchingClient
+	Redis          *db.Redis
+	Delay          time.Duration
+}
+
+// Rebuilder asks matching to rebuild the candidate pool of a user once their settings stop changing.
+// Every Schedule call for the same user within Delay postpones the rebuild, so a burst of edits
+// results in a single UpdateRedis call.
+//
+// Edits of one user can reach different replicas. Each Schedule call stores a new token in Redis
+// and a timer only rebuilds if its token is still the last one, so the rebuild happens once, on
+// the replica that saw the last edit. Without Redis the debounce only covers this replica.
+type Rebuilder struct {
+	Logger         *slog.Logger
+	MatchingClient pb.MatchingClient
+	Redis          *db.Redis
+	Delay          time.Duration
+	mu             sync.Mutex
+	timers         map[int64]*time.Timer
+}
+
+func rebuildKey(userId int64) string {
+	return fmt.Sprintf("user:%d:rebuild", userId)
+}
+
+func NewRebuilder(deps *RebuilderDeps) *Rebuilder {
+	delay := deps.Delay
+	if delay <= 0 {
+		delay = defaultRebuildDelay
+	}
+	return &Rebuilder{
+		Logger:         deps.Logger,
+		MatchingClient: deps.MatchingClient,
+		Redis:          deps.Redis,
+		Delay:          delay,
+		timers:         make(map[int64]*time.Timer),
+	}
+}
+
+func (rebuilder *Rebuilder) Schedule(userId int64) {
+	token := rebuilder.claim(userId)
+	rebuilder.mu.Lock()
+	defer rebuilder.mu.Unlock()
+	if timer, ok := rebuilder.timers[userId]; ok {
+		timer.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(rebuilder.Delay, func() {
+		rebuilder.rebuild(userId, timer, token)
+	})
+	rebuilder.timers[userId] = timer
+}
+
+// claim makes this call the last edit of the user across replicas and returns its token, empty
+// when the token could not be stored and the rebuild is left to this replica alone.
+func (rebuilder *Rebuilder) claim(userId int64) string {
+	if rebuilder.Redis == nil {
+		return ""
+	}
+	token, err := newToken()
+	if err != nil {
+		rebuilder.Logger.Error(err.Error(), slog.String("Error location", "rebuilder.newToken"))
+		return ""
+	}
+	// The key outlives the timer, an expired key means the replica holding it stopped.
+	err = rebuilder.Redis.Set(context.Background(), rebuildKey(userId), token, rebuilder.Delay+rebuildTimeout).Err()
+	if err != nil {
+		rebuilder.Logger.Error(err.Error(),
+			slog.String("Error location", "rebuilder.Redis.Set"),
+			slog.Int64("User id", userId),
+		)
+		return ""
+	}
+	return token
+}
+
+// isLast reports whether token is still the last one stored for the user. A failed lookup counts
+// as last, a redundant rebuild is better than a missing one.
+func (rebuilder *Rebuilder) isLast(ctx context.Context, userId int64, token string) bool {
+	if token == "" {
+		return true
+	}
+	key := rebuildKey(userId)
+	last, err := rebuilder.Redis.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return false
+	}
+	if err != nil {
+		rebuilder.Logger.Error(err.Error(),
+			slog.String("Error location", "rebuilder.Redis.Get"),
+			slog.Int64("User id", userId),
+		)
+		return true
+	}
+	if last != token {
+		return false
+	}
+	// A token stored after the check is dropped with it, this rebuild runs later and reads that edit.
+	rebuilder.Redis.Del(ctx, key)
+	return true
+}
+
+func (rebuilder *Rebuilder) rebuild(userId int64, timer *time.Timer, token string) {
+	rebuilder.mu.Lock()
+	if rebuilder.timers[userId] != timer {
+		rebuilder.mu.Unlock()
+		return
+	}
+	delete(rebuilder.timers, userId)
+	rebuilder.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), rebuildTimeout)
+	defer cancel()
+	if !rebuilder.isLast(ctx, userId, token) {
+		return
+	}
+	_, err := rebuilder.MatchingClient.UpdateRedis(ctx, &pb.UpdateRedisReq{UserId: userId})
+	if err != nil {
+		rebuilder.Logger.Error(err.Error(),
+			slog.String("Error location", "rebuilder.MatchingClient.UpdateRedis"),
+			slog.Int64("User id", userId),
+		)
+	}
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 8)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
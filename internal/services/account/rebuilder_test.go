@@ -0,0 +1,86 @@
+package account
+
+import (
+	"context"
+	"flame/pkg/logger"
+	"flame/pkg/pb"
+	"flame/tests/mocks"
+	"github.com/go-playground/assert/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// rebuildCounter counts the UpdateRedis calls of one replica.
+type rebuildCounter struct {
+	pb.MatchingClient
+	mu    sync.Mutex
+	calls int
+}
+
+func (client *rebuildCounter) UpdateRedis(ctx context.Context, in *pb.UpdateRedisReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.calls++
+	return &emptypb.Empty{}, nil
+}
+
+func (client *rebuildCounter) count() int {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.calls
+}
+
+func TestRebuilder_Schedule_Replicas(t *testing.T) {
+	const delay = 20 * time.Millisecond
+	tests := []struct {
+		name string
+		// edits are the replicas the edits of user 1 reach, in order.
+		edits []int
+		calls []int
+	}{
+		{
+			name:  "one replica",
+			edits: []int{0, 0, 0},
+			calls: []int{1, 0},
+		},
+		{
+			name:  "last edit on another replica",
+			edits: []int{0, 1},
+			calls: []int{0, 1},
+		},
+		{
+			name:  "edits back and forth",
+			edits: []int{1, 0, 1, 0},
+			calls: []int{1, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, rdb := mocks.NewRedis(t)
+			clients := []*rebuildCounter{{}, {}}
+			replicas := make([]*Rebuilder, len(clients))
+			for i, client := range clients {
+				replicas[i] = NewRebuilder(&RebuilderDeps{
+					Logger:         logger.NewLogger(os.Stdout),
+					MatchingClient: client,
+					Redis:          rdb,
+					Delay:          delay,
+				})
+			}
+			for _, replica := range tt.edits {
+				replicas[replica].Schedule(1)
+			}
+			time.Sleep(5 * delay)
+
+			for i, client := range clients {
+				assert.Equal(t, client.count(), tt.calls[i])
+			}
+			_, ok := server.Get(rebuildKey(1))
+			assert.Equal(t, ok, false)
+		})
+	}
+}
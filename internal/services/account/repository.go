@@ -3,10 +3,14 @@ package account
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"flame/internal/models"
 	"flame/pkg/db"
+	"flame/pkg/events"
 	"fmt"
+	"github.com/lib/pq"
 	"reflect"
+	"time"
 )
 
 type Repository struct {
@@ -52,19 +56,29 @@ func (repo *Repository) Create(user *models.User) (int64, error) {
 	if err != nil {
 		return -1, err
 	}
-	err = repo.DB.QueryRow(`INSERT INTO users (email, password, name, location) 
+	err = tr.QueryRow(`INSERT INTO users (email, password, name, location) 
 																   VALUES ($1,$2,$3, ST_GeographyFromText($4)) RETURNING id`,
 		user.Email, user.Password, user.Name, user.Location).Scan(&id)
 	if err != nil {
 		tr.Rollback()
 		return -1, err
 	}
-	_, err = repo.DB.Exec(`INSERT INTO preferences (user_id) VALUES ($1)`, id)
+	_, err = tr.Exec(`INSERT INTO preferences (user_id) VALUES ($1)`, id)
 	if err != nil {
 		tr.Rollback()
 		return -1, err
 	}
-	tr.Commit()
+	err = events.Publish(tr, events.AccountStream, events.UserRegistered, events.UserRegisteredPayload{
+		UserId: id,
+	})
+	if err != nil {
+		tr.Rollback()
+		return -1, err
+	}
+	err = tr.Commit()
+	if err != nil {
+		return -1, err
+	}
 	return id, nil
 }
 
@@ -74,6 +88,8 @@ func (repo *Repository) UpdateProfile(user *models.User) error {
 	count := 1
 
 	var args []interface{}
+	locationChanged := false
+	profileChanged := false
 
 	val := reflect.ValueOf(*user)
 	typ := reflect.TypeOf(*user)
@@ -92,7 +108,11 @@ func (repo *Repository) UpdateProfile(user *models.User) error {
 				}
 				query += fmt.Sprintf(" %s=$%d", fieldType.Tag.Get("db"), count)
 				args = append(args, fieldValue.Interface().(*string))
-
+				if fieldType.Name == "Location" {
+					locationChanged = true
+				} else {
+					profileChanged = true
+				}
 				count++
 			}
 		} else if fieldValue.Kind() == reflect.String {
@@ -104,6 +124,7 @@ func (repo *Repository) UpdateProfile(user *models.User) error {
 				}
 				query += fmt.Sprintf(" %s=$%d", fieldType.Tag.Get("db"), count)
 				args = append(args, fieldValue.Interface().(string))
+				profileChanged = true
 				count++
 			}
 		}
@@ -113,17 +134,59 @@ func (repo *Repository) UpdateProfile(user *models.User) error {
 	}
 	query += fmt.Sprintf(" WHERE id=$%d", count)
 	args = append(args, user.Id)
-	_, err := repo.DB.Exec(query, args...)
-	return err
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	result, err := tr.Exec(query, args...)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return tr.Commit()
+	}
+	if locationChanged {
+		err = events.Publish(tr, events.AccountStream, events.LocationChanged, events.LocationChangedPayload{
+			UserId:   user.Id,
+			Location: *user.Location,
+		})
+		if err != nil {
+			tr.Rollback()
+			return err
+		}
+	}
+	if profileChanged {
+		err = events.Publish(tr, events.AccountStream, events.ProfileUpdated, events.ProfileUpdatedPayload{
+			UserId: user.Id,
+		})
+		if err != nil {
+			tr.Rollback()
+			return err
+		}
+	}
+	return tr.Commit()
 }
 
 func (repo *Repository) UploadPhoto(userId int64, link string) (*int64, error) {
 	var id int64
-	err := repo.DB.QueryRow(`INSERT INTO user_photos (user_id, photo_url) VALUES ($1, $2) RETURNING id`, userId, link).Scan(&id)
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	err = tr.QueryRow(`INSERT INTO user_photos (user_id, photo_url) VALUES ($1, $2) RETURNING id`, userId, link).Scan(&id)
+	if err != nil {
+		tr.Rollback()
+		return nil, err
+	}
+	err = events.Publish(tr, events.AccountStream, events.ProfileUpdated, events.ProfileUpdatedPayload{
+		UserId: userId,
+	})
 	if err != nil {
+		tr.Rollback()
 		return nil, err
 	}
-	return &id, nil
+	return &id, tr.Commit()
 }
 
 func (repo *Repository) SetMainPhoto(userId int64, mainPhotoId int64) error {
@@ -132,19 +195,25 @@ func (repo *Repository) SetMainPhoto(userId int64, mainPhotoId int64) error {
 	if err != nil {
 		return err
 	}
-	_, err = repo.DB.Exec(`UPDATE user_photos SET is_main=false WHERE user_id=$1 AND is_main=true`, userId)
+	_, err = tr.Exec(`UPDATE user_photos SET is_main=false WHERE user_id=$1 AND is_main=true`, userId)
 	if err != nil {
 		tr.Rollback()
 		return err
 	}
 
-	_, err = repo.DB.Exec(`UPDATE user_photos SET is_main=true WHERE id=$1`, mainPhotoId)
+	_, err = tr.Exec(`UPDATE user_photos SET is_main=true WHERE id=$1`, mainPhotoId)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	err = events.Publish(tr, events.AccountStream, events.ProfileUpdated, events.ProfileUpdatedPayload{
+		UserId: userId,
+	})
 	if err != nil {
 		tr.Rollback()
 		return err
 	}
-	tr.Commit()
-	return nil
+	return tr.Commit()
 }
 
 func (repo *Repository) GetUserProfilePhotos(userId int64) []models.UserPhoto {
@@ -165,8 +234,38 @@ func (repo *Repository) GetPhoto(photoId int64) *models.UserPhoto {
 }
 
 func (repo *Repository) DeletePhoto(photoId int64) error {
-	_, err := repo.DB.Exec(`DELETE FROM user_photos WHERE id=$1`, photoId)
-	return err
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	var userId int64
+	err = tr.QueryRow(`DELETE FROM user_photos WHERE id=$1 RETURNING user_id`, photoId).Scan(&userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return tr.Commit()
+	}
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	err = events.Publish(tr, events.AccountStream, events.ProfileUpdated, events.ProfileUpdatedPayload{
+		UserId: userId,
+	})
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	return tr.Commit()
+}
+
+func (repo *Repository) GetPhotoUrls() ([]string, error) {
+	var urls []string
+	err := repo.DB.Select(&urls, `SELECT photo_url FROM user_photos`)
+	return urls, err
+}
+
+// PurgeOutbox deletes events published before the given time.
+func (repo *Repository) PurgeOutbox(before time.Time) (int64, error) {
+	return events.Purge(repo.DB, before)
 }
 
 func (repo *Repository) GetLastUserPhoto(userId int64) *models.UserPhoto {
@@ -243,6 +342,277 @@ func (repo *Repository) UpdatePreferences(pref *models.UserPreferences) error {
 	}
 	query += fmt.Sprintf(" WHERE user_id=$%d", count)
 	args = append(args, pref.UserId)
-	_, err := repo.DB.Exec(query, args...)
+	// In strict mode the preferences of a user decide whose pools they are in, so pools are
+	// synced like after a profile change.
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	_, err = tr.Exec(query, args...)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	err = events.Publish(tr, events.AccountStream, events.ProfileUpdated, events.ProfileUpdatedPayload{
+		UserId: pref.UserId,
+	})
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	return tr.Commit()
+}
+
+func (repo *Repository) GetInterestedIn(userId int64) []string {
+	var genders []string
+	err := repo.DB.Select(&genders, `SELECT gender FROM interested_in WHERE user_id=$1 ORDER BY gender`, userId)
+	if err != nil {
+		return nil
+	}
+	return genders
+}
+
+// SetInterestedIn replaces the genders userId is interested in.
+func (repo *Repository) SetInterestedIn(userId int64, genders []string) error {
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	_, err = tr.Exec(`DELETE FROM interested_in WHERE user_id=$1`, userId)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	if len(genders) != 0 {
+		_, err = tr.Exec(`INSERT INTO interested_in (user_id, gender)
+			SELECT $1, unnest($2::user_gender[])`, userId, pq.Array(genders))
+		if err != nil {
+			tr.Rollback()
+			return err
+		}
+	}
+	err = events.Publish(tr, events.AccountStream, events.ProfileUpdated, events.ProfileUpdatedPayload{
+		UserId: userId,
+	})
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	return tr.Commit()
+}
+
+func (repo *Repository) Block(blockerId, blockedId int64) error {
+	_, err := repo.DB.Exec(`INSERT INTO blocks (blocker_id, blocked_id) VALUES ($1,$2)
+		ON CONFLICT (blocker_id, blocked_id) DO NOTHING`, blockerId, blockedId)
+	return err
+}
+
+func (repo *Repository) IsBlocked(userId1, userId2 int64) (bool, error) {
+	var exists bool
+	err := repo.DB.Get(&exists, `SELECT EXISTS(SELECT 1 FROM blocks 
+		WHERE (blocker_id=$1 AND blocked_id=$2) OR (blocker_id=$2 AND blocked_id=$1))`, userId1, userId2)
+	return exists, err
+}
+
+func (repo *Repository) CreateReport(report *models.Report) (int64, error) {
+	var id int64
+	err := repo.DB.QueryRow(`INSERT INTO reports (reporter_id, reported_id, reason, comment) VALUES ($1,$2,$3,$4) RETURNING id`,
+		report.ReporterId, report.ReportedId, report.Reason, report.Comment).Scan(&id)
+	if err != nil {
+		return -1, err
+	}
+	return id, nil
+}
+
+func (repo *Repository) RemoveCandidateFromRedis(candidatesKey string, userId int64) error {
+	return repo.Redis.ZRem(context.Background(), candidatesKey, userId).Err()
+}
+
+// IncrLocationJumps counts a location change in the current window and returns the count and the
+// time left until the window ends.
+func (repo *Repository) IncrLocationJumps(userId int64, window time.Duration) (int64, time.Duration, error) {
+	ctx := context.Background()
+	key := fmt.Sprintf("user:%d:location_jumps", userId)
+	// The window is started and counted in one transaction, a counter without an expiration would
+	// block the user for good.
+	pipe := repo.Redis.TxPipeline()
+	pipe.SetNX(ctx, key, 0, window)
+	count := pipe.Incr(ctx, key)
+	ttl := pipe.PTTL(ctx, key)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	if ttl.Val() < 0 {
+		// The key lost its expiration, start the window again.
+		err = repo.Redis.Expire(ctx, key, window).Err()
+		if err != nil {
+			return 0, 0, err
+		}
+		return count.Val(), window, nil
+	}
+	return count.Val(), ttl.Val(), nil
+}
+
+func (repo *Repository) DeleteCandidatesFromRedis(userId int64) error {
+	return repo.Redis.Del(context.Background(), fmt.Sprintf("user:%d:candidates", userId)).Err()
+}
+
+func (repo *Repository) GetOpenReports(cursor *int64, limit int32) ([]models.Report, error) {
+	var reports []models.Report
+	err := repo.DB.Select(&reports, `SELECT * FROM reports 
+		WHERE status='open' AND ($1::bigint IS NULL OR id > $1)
+		ORDER BY id
+		LIMIT $2`, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+func (repo *Repository) GetReport(reportId int64) *models.Report {
+	var report models.Report
+	err := repo.DB.Get(&report, `SELECT * FROM reports WHERE id=$1`, reportId)
+	if err != nil {
+		return nil
+	}
+	return &report
+}
+
+func (repo *Repository) ResolveReport(reportId, adminId int64, status string) error {
+	_, err := repo.DB.Exec(`UPDATE reports SET status=$3, resolved_by=$2, resolved_at=now() WHERE id=$1`,
+		reportId, adminId, status)
 	return err
 }
+
+func (repo *Repository) GetReportsByUser(userId int64) []models.Report {
+	var reports []models.Report
+	err := repo.DB.Select(&reports, `SELECT * FROM reports WHERE reported_id=$1 ORDER BY id DESC`, userId)
+	if err != nil {
+		return nil
+	}
+	return reports
+}
+
+// CreateSanction stores the sanction and moves the user to the matching status.
+func (repo *Repository) CreateSanction(sanction *models.Sanction) (int64, error) {
+	var id int64
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return -1, err
+	}
+	err = tr.QueryRow(`INSERT INTO sanctions (user_id, admin_id, kind, reason, expires_at) VALUES ($1,$2,$3,$4,$5) RETURNING id`,
+		sanction.UserId, sanction.AdminId, sanction.Kind, sanction.Reason, sanction.ExpiresAt).Scan(&id)
+	if err != nil {
+		tr.Rollback()
+		return -1, err
+	}
+	userStatus := models.StatusSuspended
+	if models.SanctionKind(sanction.Kind) == models.Ban {
+		userStatus = models.StatusBanned
+	}
+	// A ban is never replaced and a suspension only ever gets longer, a shorter one is recorded
+	// but does not end the current one early. GREATEST ignores NULL.
+	_, err = tr.Exec(`UPDATE users SET status=$2, suspended_until=GREATEST(suspended_until, $3::timestamptz), updated_at=now()
+		WHERE id=$1 AND status != 'banned'`, sanction.UserId, userStatus, sanction.ExpiresAt)
+	if err != nil {
+		tr.Rollback()
+		return -1, err
+	}
+	// Matching drops the user from the cached pools once it sees the new status.
+	err = events.Publish(tr, events.AccountStream, events.ProfileUpdated, events.ProfileUpdatedPayload{
+		UserId: sanction.UserId,
+	})
+	if err != nil {
+		tr.Rollback()
+		return -1, err
+	}
+	return id, tr.Commit()
+}
+
+func (repo *Repository) SetStatusRedis(userId int64, status models.UserStatus, expiration time.Duration) error {
+	key := fmt.Sprintf("user:%d:status", userId)
+	return repo.Redis.Set(context.Background(), key, string(status), expiration).Err()
+}
+
+// CacheActiveStatus caches that the user is active unless a status is already cached, so it
+// cannot overwrite a sanction applied in the meantime.
+func (repo *Repository) CacheActiveStatus(userId int64, expiration time.Duration) error {
+	key := fmt.Sprintf("user:%d:status", userId)
+	return repo.Redis.SetNX(context.Background(), key, string(models.StatusActive), expiration).Err()
+}
+
+func (repo *Repository) GetSanctions(userId int64) []models.Sanction {
+	var sanctions []models.Sanction
+	err := repo.DB.Select(&sanctions, `SELECT * FROM sanctions WHERE user_id=$1 ORDER BY created_at DESC`, userId)
+	if err != nil {
+		return nil
+	}
+	return sanctions
+}
+
+func (repo *Repository) TouchLastActive(userId int64) error {
+	_, err := repo.DB.Exec(`UPDATE users SET last_active_at=now() WHERE id=$1`, userId)
+	return err
+}
+
+// SetTravel replaces the travel location of the user. Pools are told the location changed, the
+// travel location is used by discovery between startsAt and endsAt.
+func (repo *Repository) SetTravel(userId int64, location string, startsAt, endsAt time.Time) error {
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	_, err = tr.Exec(`UPDATE users SET travel_location=ST_GeographyFromText($2), travel_starts_at=$3, travel_ends_at=$4, updated_at=now()
+		WHERE id=$1`, userId, location, startsAt, endsAt)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	err = events.Publish(tr, events.AccountStream, events.LocationChanged, events.LocationChangedPayload{
+		UserId:   userId,
+		Location: location,
+	})
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	return tr.Commit()
+}
+
+func (repo *Repository) GetTravel(userId int64) *models.Travel {
+	var travel models.Travel
+	err := repo.DB.Get(&travel, `SELECT st_x(ST_AsText(travel_location)::geometry) as lon, st_y(ST_AsText(travel_location)::geometry) as lat, travel_starts_at, travel_ends_at
+		FROM users WHERE id=$1 AND travel_location IS NOT NULL`, userId)
+	if err != nil {
+		return nil
+	}
+	return &travel
+}
+
+// EndTravel removes the travel location and reports whether the user had one.
+func (repo *Repository) EndTravel(userId int64) (bool, error) {
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return false, err
+	}
+	var location sql.NullString
+	err = tr.QueryRow(`UPDATE users SET travel_location=NULL, travel_starts_at=NULL, travel_ends_at=NULL, updated_at=now()
+		WHERE id=$1 AND travel_location IS NOT NULL RETURNING ST_AsEWKT(location)`, userId).Scan(&location)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, tr.Commit()
+	}
+	if err != nil {
+		tr.Rollback()
+		return false, err
+	}
+	err = events.Publish(tr, events.AccountStream, events.LocationChanged, events.LocationChangedPayload{
+		UserId:   userId,
+		Location: location.String,
+	})
+	if err != nil {
+		tr.Rollback()
+		return false, err
+	}
+	return true, tr.Commit()
+}
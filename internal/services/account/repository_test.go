@@ -4,6 +4,7 @@ import (
 	"errors"
 	"flame/internal/models"
 	"flame/pkg/db"
+	"flame/pkg/events"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-playground/assert/v2"
 	"github.com/jmoiron/sqlx"
@@ -16,16 +17,14 @@ func TestRepository_GetById(t *testing.T) {
 	require.NoError(t, err)
 	defer database.Close()
 	sqlxDB := sqlx.NewDb(database, "postgres")
-	repo := NewRepository(&db.DB{
-		DB: sqlxDB,
+	repo := NewRepository(&RepositoryDeps{
+		DB: &db.DB{DB: sqlxDB},
 	})
 	user := &models.User{
-		Id:         1,
-		Email:      "test@gmail.com",
-		Password:   "123456",
-		Gender:     "male",
-		LookingFor: "female",
-		Name:       "test",
+		Id:       1,
+		Email:    "test@gmail.com",
+		Password: "123456",
+		Name:     "test",
 	}
 	tests := []struct {
 		name string
@@ -38,9 +37,9 @@ func TestRepository_GetById(t *testing.T) {
 			id:   1,
 			res:  user,
 			db: func() {
-				row := sqlmock.NewRows([]string{"id", "email", "password", "gender", "looking_for", "name"}).
-					AddRow(user.Id, user.Email, user.Password, user.Gender, user.LookingFor, user.Name)
-				mock.ExpectQuery("SELECT * FROM users WHERE").WillReturnRows(row)
+				row := sqlmock.NewRows([]string{"id", "email", "password", "name"}).
+					AddRow(user.Id, user.Email, user.Password, user.Name)
+				mock.ExpectQuery("SELECT (.+) FROM users WHERE").WillReturnRows(row)
 			},
 		},
 		{
@@ -48,7 +47,7 @@ func TestRepository_GetById(t *testing.T) {
 			id:   1,
 			res:  nil,
 			db: func() {
-				mock.ExpectQuery("SELECT * FROM users WHERE").WillReturnError(errors.New(""))
+				mock.ExpectQuery("SELECT (.+) FROM users WHERE").WillReturnError(errors.New(""))
 			},
 		},
 	}
@@ -61,3 +60,106 @@ func TestRepository_GetById(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_CreateSanction(t *testing.T) {
+	database, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer database.Close()
+	sqlxDB := sqlx.NewDb(database, "postgres")
+	repo := NewRepository(&RepositoryDeps{
+		DB: &db.DB{DB: sqlxDB},
+	})
+	adminId := int64(1)
+	expiresAt := "2030-01-01T00:00:00Z"
+	// The update keeps the longest suspension and never touches a banned user.
+	const update = `UPDATE users SET status=\$2, suspended_until=GREATEST\(suspended_until, \$3::timestamptz\)(.+)WHERE id=\$1 AND status != 'banned'`
+	tests := []struct {
+		name     string
+		sanction *models.Sanction
+		res      int64
+		isErr    bool
+		db       func()
+	}{
+		{
+			name:     "ban",
+			sanction: &models.Sanction{UserId: 2, AdminId: &adminId, Kind: string(models.Ban), Reason: "spam"},
+			res:      10,
+			db: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO sanctions").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+				mock.ExpectExec(update).
+					WithArgs(int64(2), models.StatusBanned, nil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO outbox").
+					WithArgs(sqlmock.AnyArg(), events.AccountStream, events.ProfileUpdated, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:     "suspension",
+			sanction: &models.Sanction{UserId: 2, AdminId: &adminId, Kind: string(models.Suspend), Reason: "spam", ExpiresAt: &expiresAt},
+			res:      11,
+			db: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO sanctions").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+				mock.ExpectExec(update).
+					WithArgs(int64(2), models.StatusSuspended, &expiresAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO outbox").
+					WithArgs(sqlmock.AnyArg(), events.AccountStream, events.ProfileUpdated, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:     "insert fails",
+			sanction: &models.Sanction{UserId: 2, AdminId: &adminId, Kind: string(models.Ban), Reason: "spam"},
+			res:      -1,
+			isErr:    true,
+			db: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO sanctions").WillReturnError(errors.New(""))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:     "update fails",
+			sanction: &models.Sanction{UserId: 2, AdminId: &adminId, Kind: string(models.Ban), Reason: "spam"},
+			res:      -1,
+			isErr:    true,
+			db: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO sanctions").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+				mock.ExpectExec(update).WillReturnError(errors.New(""))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name:     "publish fails",
+			sanction: &models.Sanction{UserId: 2, AdminId: &adminId, Kind: string(models.Ban), Reason: "spam"},
+			res:      -1,
+			isErr:    true,
+			db: func() {
+				mock.ExpectBegin()
+				mock.ExpectQuery("INSERT INTO sanctions").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(13))
+				mock.ExpectExec(update).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO outbox").WillReturnError(errors.New(""))
+				mock.ExpectRollback()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.db()
+			res, err := repo.CreateSanction(tt.sanction)
+			assert.Equal(t, res, tt.res)
+			assert.Equal(t, err != nil, tt.isErr)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package account
 
 import (
+	"flame/internal/config"
 	"flame/internal/interfaces"
 	"flame/internal/mappers"
 	"flame/internal/models"
 	http_errors "flame/pkg/errors"
+	"flame/pkg/geo"
 	"flame/pkg/jwt"
 	"flame/pkg/pb"
 	"fmt"
@@ -13,24 +15,40 @@ import (
 	"google.golang.org/grpc/status"
 	"log/slog"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 )
 
+const (
+	defaultReportsLimit = 50
+	maxReportsLimit     = 100
+	maxTravelDuration   = 30 * 24 * time.Hour
+	// activeStatusTTL is how long middleware.IsAuthed trusts a cached active status before it
+	// asks again. Sanctions overwrite the cached status right away.
+	activeStatusTTL = 10 * time.Minute
+
+	// The location jump limit fails open: if Redis cannot count a jump the change is allowed, so a
+	// Redis outage does not stop users from updating their location.
+	defaultLocationJumpLimit  = 5
+	defaultLocationJumpWindow = time.Hour
+)
+
 type ServiceDeps struct {
 	Repository interfaces.AccountRepository
 	Logger     *slog.Logger
+	Config     *config.Config
 }
 type Service struct {
 	Logger     *slog.Logger
 	Repository interfaces.AccountRepository
+	Config     *config.Config
 }
 
 func NewService(deps *ServiceDeps) *Service {
 	return &Service{
 		Logger:     deps.Logger,
 		Repository: deps.Repository,
+		Config:     deps.Config,
 	}
 }
 
@@ -55,16 +73,19 @@ func (service *Service) Login(email, password, location string) (int64, error) {
 	if user == nil {
 		return -1, status.Errorf(codes.InvalidArgument, http_errors.InvalidNameOrPassword)
 	}
-	if location != "" {
-		u := &models.User{
-			Location: getLocation(location),
-		}
-		service.Repository.UpdateProfile(u)
-	}
 	err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
 	if err != nil {
 		return -1, status.Errorf(codes.InvalidArgument, http_errors.InvalidNameOrPassword)
 	}
+	err = service.checkStatus(user)
+	if err != nil {
+		return -1, err
+	}
+	if location != "" {
+		// The location is saved like by UpdateLocation, so logging in again does not get around the
+		// location jump limit. An invalid location or one over the limit does not fail the login.
+		service.UpdateLocation(user.Id, location)
+	}
 	return user.Id, nil
 }
 
@@ -82,7 +103,10 @@ func (service *Service) Register(data *interfaces.AccountSRegisterDeps) (int64,
 		)
 		return -1, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
 	}
-	loc := getLocation(data.Location)
+	loc, err := getLocation(data.Location)
+	if err != nil {
+		return -1, status.Errorf(codes.InvalidArgument, http_errors.LocationIsInvalid)
+	}
 	user := &models.User{
 		Email:    data.Email,
 		Password: string(hashPassword),
@@ -106,8 +130,20 @@ func (service *Service) GetTokens(secret string, data jwt.Data) (*interfaces.Acc
 	if user == nil {
 		return nil, status.Errorf(codes.InvalidArgument, http.StatusText(http.StatusBadRequest))
 	}
+	err := service.checkStatus(user)
+	if err != nil {
+		return nil, err
+	}
+	err = service.Repository.TouchLastActive(user.Id)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.TouchLastActive"),
+			slog.Int64("UserId", user.Id),
+		)
+	}
 	tokens, err := service.IssueToken(secret, jwt.Data{
-		Id: data.Id,
+		Id:   data.Id,
+		Role: user.Role,
 	})
 	if err != nil {
 		return nil, err
@@ -180,14 +216,15 @@ func (service *Service) GetProfile(id int64) (*pb.GetProfileRes, error) {
 	userPhotos := service.Repository.GetUserProfilePhotos(id)
 	return &pb.GetProfileRes{
 		Profile: &pb.UserProfile{
-			Id:        user.Id,
-			Name:      user.Name,
-			BirthDate: user.BirthDate,
-			City:      user.City,
-			Bio:       user.Bio,
-			Gender:    user.Gender,
-			Photos:    mappers.FromModelPhotosToGrpc(userPhotos),
-			Location:  user.Location,
+			Id:           user.Id,
+			Name:         user.Name,
+			BirthDate:    user.BirthDate,
+			City:         user.City,
+			Bio:          user.Bio,
+			Gender:       user.Gender,
+			Photos:       mappers.FromModelPhotosToGrpc(userPhotos),
+			Location:     user.Location,
+			InterestedIn: service.Repository.GetInterestedIn(id),
 		},
 	}, nil
 }
@@ -229,13 +266,21 @@ func (service *Service) DeletePhoto(userId, photoId int64) (string, error) {
 	return photo.PhotoUrl, nil
 }
 
-func (service *Service) UpdateLocation(userId int64, location string) error {
-	if location == "" {
-		return status.Errorf(codes.InvalidArgument, http_errors.LocationIsInvalid)
+// UpdateLocation reports whether the user moved far enough for the location to be saved, in which
+// case their candidate pool is dropped and has to be rebuilt. While the user is travelling the real
+// location is still saved, but discovery keeps using the travel location.
+func (service *Service) UpdateLocation(userId int64, location string) (bool, error) {
+	loc, err := getLocation(location)
+	if err != nil || loc == nil {
+		return false, status.Errorf(codes.InvalidArgument, http_errors.LocationIsInvalid)
+	}
+	var travelling bool
+	if current := service.Repository.GetById(userId); current != nil {
+		travelling = current.IsTravelling(time.Now())
 	}
 	user := &models.User{
 		Id:       userId,
-		Location: getLocation(location),
+		Location: loc,
 	}
 	distance, err := service.Repository.GetDistance(user)
 	if err != nil {
@@ -244,7 +289,7 @@ func (service *Service) UpdateLocation(userId int64, location string) error {
 			slog.Int64("User id", userId),
 			slog.String("Location", location),
 		)
-		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+		return false, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
 	}
 	pref := service.Repository.GetPreferences(userId)
 	if pref == nil {
@@ -253,22 +298,35 @@ func (service *Service) UpdateLocation(userId int64, location string) error {
 			slog.Int64("User id", userId),
 			slog.String("Location", location),
 		)
-		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+		return false, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
 	}
+	var moved bool
 	if distance == nil || int32(*distance)/1000 >= *pref.Distance {
+		if distance != nil {
+			err = service.reserveLocationJump(userId)
+			if err != nil {
+				return false, err
+			}
+		}
 		err = service.Repository.UpdateProfile(user)
 		if err != nil {
 			service.Logger.Error(err.Error(),
 				slog.String("Error location", "service.UpdateLocation"),
 				slog.String("Location", location),
 			)
-			return status.Errorf(codes.Internal, http_errors.LocationIsInvalid)
+			return false, status.Errorf(codes.Internal, http_errors.LocationIsInvalid)
+		}
+		moved = !travelling
+		if moved {
+			service.invalidateCandidates(userId)
 		}
-
-		//TODO: поиск пользователей
+	}
+	if travelling {
+		return false, nil
 	}
 	key := fmt.Sprintf("user:%d", userId)
-	lonLat := getLonLat(location)
+	point, _ := getLonLat(location)
+	lonLat := models.LonLat{Lon: point.Lon, Lat: point.Lat}
 	err = service.Repository.UpdateLocationRedis(key, lonLat)
 	if err != nil {
 		service.Logger.Error(err.Error(),
@@ -278,26 +336,131 @@ func (service *Service) UpdateLocation(userId int64, location string) error {
 			slog.Float64("Lat", lonLat.Lat),
 		)
 	}
+	return moved, nil
+}
+
+// SetTravel makes the user discoverable at location from startsAt, now if it is not set, until
+// endsAt. A previous trip is replaced.
+func (service *Service) SetTravel(userId int64, location string, startsAt *string, endsAt string) error {
+	loc, err := getLocation(location)
+	if err != nil || loc == nil {
+		return status.Errorf(codes.InvalidArgument, http_errors.LocationIsInvalid)
+	}
+	now := time.Now()
+	start := now
+	if startsAt != nil {
+		var err error
+		start, err = time.Parse(time.RFC3339, *startsAt)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, http_errors.InvalidTravelDates)
+		}
+	}
+	end, err := time.Parse(time.RFC3339, endsAt)
+	if err != nil || !end.After(now) || !end.After(start) {
+		return status.Errorf(codes.InvalidArgument, http_errors.InvalidTravelDates)
+	}
+	if end.Sub(start) > maxTravelDuration {
+		return status.Errorf(codes.InvalidArgument, http_errors.TravelTooLong)
+	}
+	err = service.reserveLocationJump(userId)
+	if err != nil {
+		return err
+	}
+	err = service.Repository.SetTravel(userId, *loc, start, end)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.SetTravel"),
+			slog.Int64("User id", userId),
+			slog.String("Location", location),
+		)
+		return status.Errorf(codes.InvalidArgument, http_errors.LocationIsInvalid)
+	}
+	service.invalidateCandidates(userId)
 	return nil
 }
-func getLocation(loc string) *string {
+
+func (service *Service) GetTravel(userId int64) *models.Travel {
+	return service.Repository.GetTravel(userId)
+}
+
+// EndTravel brings the user back to their real location and reports whether they had a trip.
+func (service *Service) EndTravel(userId int64) (bool, error) {
+	ended, err := service.Repository.EndTravel(userId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.EndTravel"),
+			slog.Int64("User id", userId),
+		)
+		return false, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	if ended {
+		service.invalidateCandidates(userId)
+	}
+	return ended, nil
+}
+
+func (service *Service) invalidateCandidates(userId int64) {
+	err := service.Repository.DeleteCandidatesFromRedis(userId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.DeleteCandidatesFromRedis"),
+			slog.Int64("User id", userId),
+		)
+	}
+}
+
+// getLocation converts a location in any format geo.Parse accepts to EWKT. The point is snapped to the grid first,
+// exact coordinates are never stored.
+func getLocation(loc string) (*string, error) {
 	if loc == "" {
+		return nil, nil
+	}
+	point, err := getLonLat(loc)
+	if err != nil {
+		return nil, err
+	}
+	l := point.EWKT()
+	return &l, nil
+}
+
+func getLonLat(loc string) (geo.Point, error) {
+	point, err := geo.Parse(loc)
+	if err != nil {
+		return geo.Point{}, err
+	}
+	return geo.Snap(point), nil
+}
+
+// reserveLocationJump counts a change of the stored location. Spoofed locations used to locate
+// other users by their distances need many of them, so only a few are allowed per window. Errors
+// from Redis are logged and the jump is allowed.
+func (service *Service) reserveLocationJump(userId int64) error {
+	limit, window := service.locationJumps()
+	count, resetIn, err := service.Repository.IncrLocationJumps(userId, window)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.IncrLocationJumps"),
+			slog.Int64("User id", userId),
+		)
 		return nil
-	} else {
-		l := fmt.Sprintf("SRID=4326;POINT%s", loc)
-		return &l
 	}
+	if count > limit {
+		return http_errors.QuotaExceeded(http_errors.TooManyLocationJumps, time.Now().Add(resetIn))
+	}
+	return nil
 }
 
-func getLonLat(loc string) models.LonLat {
-	loc = loc[1 : len(loc)-1]
-	locArr := strings.Split(loc, " ")
-	lon, _ := strconv.ParseFloat(locArr[0], 64)
-	lat, _ := strconv.ParseFloat(locArr[1], 64)
-	return models.LonLat{
-		Lon: lon,
-		Lat: lat,
+func (service *Service) locationJumps() (int64, time.Duration) {
+	limit, window := defaultLocationJumpLimit, defaultLocationJumpWindow
+	if service.Config != nil {
+		if service.Config.Location.JumpLimit > 0 {
+			limit = service.Config.Location.JumpLimit
+		}
+		if service.Config.Location.JumpWindow > 0 {
+			window = service.Config.Location.JumpWindow
+		}
 	}
+	return int64(limit), window
 }
 
 func (service *Service) UpdatePreferences(r *pb.UpdatePreferencesReq) error {
@@ -307,36 +470,315 @@ func (service *Service) UpdatePreferences(r *pb.UpdatePreferencesReq) error {
 	}
 	var pref models.UserPreferences
 	pref.UserId = r.UserId
-	if r.Age != nil && (*r.Age > 110 || *r.Age < 16) {
+	if r.AgeMin != nil && (*r.AgeMin > 110 || *r.AgeMin < 16) {
 		return status.Errorf(codes.InvalidArgument, http_errors.InvalidAge)
 	}
-	pref.Age = r.Age
+	pref.AgeMin = r.AgeMin
+
+	if r.AgeMax != nil && (*r.AgeMax > 110 || *r.AgeMax < 16) {
+		return status.Errorf(codes.InvalidArgument, http_errors.InvalidAge)
+	}
+	pref.AgeMax = r.AgeMax
+
+	if r.AgeMin != nil || r.AgeMax != nil {
+		ageMin, ageMax := r.AgeMin, r.AgeMax
+		if current := service.Repository.GetPreferences(r.UserId); current != nil {
+			if ageMin == nil {
+				ageMin = current.AgeMin
+			}
+			if ageMax == nil {
+				ageMax = current.AgeMax
+			}
+		}
+		if ageMin != nil && ageMax != nil && *ageMin > *ageMax {
+			return status.Errorf(codes.InvalidArgument, http_errors.InvalidAgeRange)
+		}
+	}
 
 	if r.Distance != nil && (*r.Distance > 50 || *r.Distance < 3) {
 		return status.Errorf(codes.InvalidArgument, http_errors.InvalidDistance)
 	}
 	pref.Distance = r.Distance
 
-	if r.Gender != nil && !models.GenderIsValid(*r.Gender) {
-		return status.Errorf(codes.InvalidArgument, http_errors.InvalidGender)
+	var interestedIn []string
+	if r.InterestedIn != nil {
+		seen := make(map[string]struct{}, len(r.InterestedIn.Genders))
+		for _, gender := range r.InterestedIn.Genders {
+			if !models.GenderIsValid(gender) {
+				return status.Errorf(codes.InvalidArgument, http_errors.InvalidGender)
+			}
+			if _, ok := seen[gender]; ok {
+				continue
+			}
+			seen[gender] = struct{}{}
+			interestedIn = append(interestedIn, gender)
+		}
 	}
-	pref.Gender = r.Gender
 
 	if r.City != nil && *r.City != "" && len(*r.City) < 2 {
 		return status.Errorf(codes.InvalidArgument, http_errors.InvalidCity)
 	}
 	pref.City = r.City
 
-	if r.Age != nil {
-		if *r.Age > 110 || *r.Age < 16 {
-			return status.Errorf(codes.InvalidArgument, http_errors.InvalidAge)
+	err := service.Repository.UpdatePreferences(&pref)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, http.StatusText(http.StatusBadRequest))
+	}
+	if r.InterestedIn != nil {
+		err = service.Repository.SetInterestedIn(r.UserId, interestedIn)
+		if err != nil {
+			service.Logger.Error(err.Error(),
+				slog.String("Error location", "service.Repository.SetInterestedIn"),
+				slog.Int64("User id", r.UserId),
+			)
+			return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
 		}
-		pref.Age = r.Age
 	}
+	service.invalidateCandidates(r.UserId)
+	return nil
+}
 
-	err := service.Repository.UpdatePreferences(&pref)
+func (service *Service) Block(userId, targetId int64) error {
+	if userId == targetId {
+		return status.Errorf(codes.InvalidArgument, http.StatusText(http.StatusBadRequest))
+	}
+	if service.Repository.GetById(targetId) == nil {
+		return status.Errorf(codes.NotFound, http.StatusText(http.StatusNotFound))
+	}
+	err := service.Repository.Block(userId, targetId)
 	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.Block"),
+			slog.Int64("UserId", userId),
+			slog.Int64("TargetId", targetId),
+		)
+		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	for _, pair := range [][2]int64{{userId, targetId}, {targetId, userId}} {
+		candidatesKey := fmt.Sprintf("user:%d:candidates", pair[0])
+		err = service.Repository.RemoveCandidateFromRedis(candidatesKey, pair[1])
+		if err != nil {
+			service.Logger.Error(err.Error(),
+				slog.String("Error location", "service.Repository.RemoveCandidateFromRedis"),
+				slog.String("CandidatesKey", candidatesKey),
+				slog.Int64("UserId", pair[1]),
+			)
+		}
+	}
+	return nil
+}
+
+func (service *Service) Report(report *models.Report) error {
+	if report.ReporterId == report.ReportedId {
 		return status.Errorf(codes.InvalidArgument, http.StatusText(http.StatusBadRequest))
 	}
+	if !models.ReportReasonIsValid(report.Reason) {
+		return status.Errorf(codes.InvalidArgument, http_errors.InvalidReportReason)
+	}
+	if service.Repository.GetById(report.ReportedId) == nil {
+		return status.Errorf(codes.NotFound, http.StatusText(http.StatusNotFound))
+	}
+	_, err := service.Repository.CreateReport(report)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.CreateReport"),
+			slog.Int64("ReporterId", report.ReporterId),
+			slog.Int64("ReportedId", report.ReportedId),
+		)
+		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	return nil
+}
+
+func (service *Service) IsBlocked(userId1, userId2 int64) (bool, error) {
+	isBlocked, err := service.Repository.IsBlocked(userId1, userId2)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.IsBlocked"),
+			slog.Int64("UserId1", userId1),
+			slog.Int64("UserId2", userId2),
+		)
+		return false, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	return isBlocked, nil
+}
+
+func (service *Service) checkAdmin(adminId int64) error {
+	admin := service.Repository.GetById(adminId)
+	if admin == nil || admin.Role != string(models.RoleAdmin) {
+		return status.Errorf(codes.PermissionDenied, http.StatusText(http.StatusForbidden))
+	}
+	return nil
+}
+
+func (service *Service) GetReports(adminId int64, cursor *int64, limit int32) ([]models.Report, *int64, error) {
+	err := service.checkAdmin(adminId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if limit <= 0 {
+		limit = defaultReportsLimit
+	}
+	if limit > maxReportsLimit {
+		limit = maxReportsLimit
+	}
+	reports, err := service.Repository.GetOpenReports(cursor, limit)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetOpenReports"),
+			slog.Int64("AdminId", adminId),
+		)
+		return nil, nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	if len(reports) < int(limit) {
+		return reports, nil, nil
+	}
+	nextCursor := reports[len(reports)-1].Id
+	return reports, &nextCursor, nil
+}
+
+func (service *Service) ResolveReport(adminId, reportId int64, reportStatus string) error {
+	err := service.checkAdmin(adminId)
+	if err != nil {
+		return err
+	}
+	if reportStatus != string(models.ReportResolved) && reportStatus != string(models.ReportDismissed) {
+		return status.Errorf(codes.InvalidArgument, http_errors.InvalidReportStatus)
+	}
+	if service.Repository.GetReport(reportId) == nil {
+		return status.Errorf(codes.NotFound, http_errors.ReportNotFound)
+	}
+	err = service.Repository.ResolveReport(reportId, adminId, reportStatus)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.ResolveReport"),
+			slog.Int64("ReportId", reportId),
+		)
+		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
 	return nil
 }
+
+func (service *Service) GetReportedUser(adminId, userId int64) (*pb.GetReportedUserRes, error) {
+	err := service.checkAdmin(adminId)
+	if err != nil {
+		return nil, err
+	}
+	user := service.Repository.GetById(userId)
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, http.StatusText(http.StatusNotFound))
+	}
+	profile, err := service.GetProfile(userId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetReportedUserRes{
+		Profile:   profile.Profile,
+		Role:      user.Role,
+		Reports:   mappers.FromModelReportsToGrpc(service.Repository.GetReportsByUser(userId)),
+		Sanctions: mappers.FromModelSanctionsToGrpc(service.Repository.GetSanctions(userId)),
+	}, nil
+}
+
+func (service *Service) SanctionUser(adminId int64, sanction *models.Sanction) error {
+	err := service.checkAdmin(adminId)
+	if err != nil {
+		return err
+	}
+	if len(sanction.Reason) == 0 || len(sanction.Reason) > 500 {
+		return status.Errorf(codes.InvalidArgument, http_errors.InvalidSanctionReason)
+	}
+	switch models.SanctionKind(sanction.Kind) {
+	case models.Ban:
+		sanction.ExpiresAt = nil
+	case models.Suspend:
+		if sanction.ExpiresAt == nil {
+			return status.Errorf(codes.InvalidArgument, http_errors.InvalidSuspension)
+		}
+		expiresAt, err := time.Parse(time.RFC3339, *sanction.ExpiresAt)
+		if err != nil || !expiresAt.After(time.Now()) {
+			return status.Errorf(codes.InvalidArgument, http_errors.InvalidSuspension)
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, http_errors.InvalidSanctionKind)
+	}
+	user := service.Repository.GetById(sanction.UserId)
+	if user == nil {
+		return status.Errorf(codes.NotFound, http.StatusText(http.StatusNotFound))
+	}
+	if user.Role == string(models.RoleAdmin) {
+		return status.Errorf(codes.PermissionDenied, http.StatusText(http.StatusForbidden))
+	}
+	sanction.AdminId = &adminId
+	_, err = service.Repository.CreateSanction(sanction)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.CreateSanction"),
+			slog.Int64("UserId", sanction.UserId),
+			slog.Int64("AdminId", adminId),
+		)
+		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	user = service.Repository.GetById(sanction.UserId)
+	if user != nil {
+		service.cacheStatus(user)
+	}
+	return nil
+}
+
+// checkStatus rejects banned and currently suspended users and refreshes the cached status
+// that middleware.IsAuthed looks up for every request.
+func (service *Service) checkStatus(user *models.User) error {
+	switch user.CurrentStatus(time.Now()) {
+	case models.StatusBanned:
+		service.cacheStatus(user)
+		return status.Errorf(codes.PermissionDenied, http_errors.AccountBanned)
+	case models.StatusSuspended:
+		service.cacheStatus(user)
+		return status.Errorf(codes.PermissionDenied, fmt.Sprintf("%s until %s",
+			http_errors.AccountSuspended, user.SuspendedUntil.UTC().Format(time.RFC3339)))
+	}
+	return nil
+}
+
+func (service *Service) cacheStatus(user *models.User) {
+	var err error
+	userStatus := user.CurrentStatus(time.Now())
+	switch userStatus {
+	case models.StatusBanned:
+		err = service.Repository.SetStatusRedis(user.Id, userStatus, 0)
+	case models.StatusSuspended:
+		err = service.Repository.SetStatusRedis(user.Id, userStatus, time.Until(*user.SuspendedUntil))
+	default:
+		err = service.Repository.CacheActiveStatus(user.Id, activeStatusTTL)
+	}
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.SetStatusRedis"),
+			slog.Int64("UserId", user.Id),
+		)
+	}
+}
+
+// GetStatus returns the current status of the user and caches it for middleware.IsAuthed, which
+// asks for it when the cached status is missing.
+func (service *Service) GetStatus(userId int64) (models.UserStatus, error) {
+	user := service.Repository.GetById(userId)
+	if user == nil {
+		return "", status.Errorf(codes.NotFound, http.StatusText(http.StatusNotFound))
+	}
+	service.cacheStatus(user)
+	return user.CurrentStatus(time.Now()), nil
+}
+
+func (service *Service) RemovePhoto(adminId, photoId int64) (string, error) {
+	err := service.checkAdmin(adminId)
+	if err != nil {
+		return "", err
+	}
+	photo := service.Repository.GetPhoto(photoId)
+	if photo == nil || photo.UserId == nil {
+		return "", status.Errorf(codes.NotFound, http.StatusText(http.StatusNotFound))
+	}
+	return service.DeletePhoto(*photo.UserId, photoId)
+}
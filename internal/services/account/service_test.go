@@ -11,6 +11,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"os"
 	"testing"
 	"time"
@@ -32,11 +34,9 @@ func TestService_Register(t *testing.T) {
 		badPassword += "1"
 	}
 	validData := &interfaces.AccountSRegisterDeps{
-		Name:       "test",
-		Password:   "123456",
-		Email:      "test@gmail.com",
-		Gender:     "male",
-		LookingFor: "female",
+		Name:     "test",
+		Password: "123456",
+		Email:    "test@gmail.com",
 	}
 	tests := []struct {
 		name  string
@@ -83,11 +83,9 @@ func TestService_Register(t *testing.T) {
 		{
 			name: "bad hash password",
 			input: &interfaces.AccountSRegisterDeps{
-				Name:       "test",
-				Password:   badPassword,
-				Email:      "test@gmail.com",
-				Gender:     "male",
-				LookingFor: "female",
+				Name:     "test",
+				Password: badPassword,
+				Email:    "test@gmail.com",
 			},
 			res: response{
 				id:    -1,
@@ -176,12 +174,10 @@ func TestService_Login(t *testing.T) {
 	}
 	hashPassword, _ := bcrypt.GenerateFromPassword([]byte(validInput.password), bcrypt.DefaultCost)
 	user := &models.User{
-		Id:         1,
-		Email:      "test@gmail.com",
-		Password:   string(hashPassword),
-		Gender:     "male",
-		LookingFor: "female",
-		Name:       "test",
+		Id:       1,
+		Email:    "test@gmail.com",
+		Password: string(hashPassword),
+		Name:     "test",
 	}
 	tests := []struct {
 		name     string
@@ -235,7 +231,7 @@ func TestService_Login(t *testing.T) {
 					repo.ExpectedCalls = nil
 				})
 			}
-			id, err := service.Login(tt.email, tt.password)
+			id, err := service.Login(tt.email, tt.password, "")
 			assert.Equal(t, id, tt.res.id)
 			assert.Equal(t, err != nil, tt.res.isErr)
 		})
@@ -262,11 +258,9 @@ func TestService_GetTokens(t *testing.T) {
 	validAccessToken, _ := j.Create(jwtData, time.Now().Add(time.Hour*2).Add(time.Minute*10))
 	validRefreshToken, _ := j.Create(jwtData, time.Now().AddDate(0, 0, 2).Add(time.Hour*2))
 	user := &models.User{
-		Id:         1,
-		Email:      "test@gmail.com",
-		Gender:     "male",
-		LookingFor: "female",
-		Name:       "test",
+		Id:    1,
+		Email: "test@gmail.com",
+		Name:  "test",
 	}
 	tests := []struct {
 		name   string
@@ -288,6 +282,7 @@ func TestService_GetTokens(t *testing.T) {
 			},
 			repo: func() {
 				repo.On("GetById", mock.Anything).Return(user)
+				repo.On("TouchLastActive", mock.Anything).Return(nil)
 			},
 		},
 		{
@@ -318,3 +313,195 @@ func TestService_GetTokens(t *testing.T) {
 
 	}
 }
+
+func TestService_SanctionUser(t *testing.T) {
+	log := logger.NewLogger(os.Stdout)
+	repo := new(mocks.MockAccountRepository)
+	service := NewService(&ServiceDeps{
+		Logger:     log,
+		Repository: repo,
+	})
+	admin := &models.User{Id: 1, Role: string(models.RoleAdmin)}
+	suspendedUntil := time.Now().Add(time.Hour)
+	expiresAt := suspendedUntil.Format(time.RFC3339)
+	past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+	tests := []struct {
+		name     string
+		sanction *models.Sanction
+		code     codes.Code
+		repo     func()
+	}{
+		{
+			name:     "ban",
+			sanction: &models.Sanction{UserId: 2, Kind: string(models.Ban), Reason: "spam"},
+			code:     codes.OK,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(admin)
+				repo.On("GetById", int64(2)).Return(&models.User{Id: 2, Role: string(models.RoleUser)}).Once()
+				repo.On("CreateSanction", mock.Anything).Return(1, nil)
+				repo.On("GetById", int64(2)).Return(&models.User{Id: 2, Status: string(models.StatusBanned)}).Once()
+				repo.On("SetStatusRedis", int64(2), models.StatusBanned, time.Duration(0)).Return(nil)
+			},
+		},
+		{
+			name:     "suspension",
+			sanction: &models.Sanction{UserId: 2, Kind: string(models.Suspend), Reason: "spam", ExpiresAt: &expiresAt},
+			code:     codes.OK,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(admin)
+				repo.On("GetById", int64(2)).Return(&models.User{Id: 2, Role: string(models.RoleUser)}).Once()
+				repo.On("CreateSanction", mock.Anything).Return(1, nil)
+				repo.On("GetById", int64(2)).Return(&models.User{
+					Id:             2,
+					Status:         string(models.StatusSuspended),
+					SuspendedUntil: &suspendedUntil,
+				}).Once()
+				repo.On("SetStatusRedis", int64(2), models.StatusSuspended, mock.MatchedBy(func(ttl time.Duration) bool {
+					return ttl > 0 && ttl <= time.Hour
+				})).Return(nil)
+			},
+		},
+		{
+			// The user was banned before, the cache keeps the ban rather than the new suspension.
+			name:     "suspension of a banned user",
+			sanction: &models.Sanction{UserId: 2, Kind: string(models.Suspend), Reason: "spam", ExpiresAt: &expiresAt},
+			code:     codes.OK,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(admin)
+				repo.On("GetById", int64(2)).Return(&models.User{Id: 2, Status: string(models.StatusBanned)})
+				repo.On("CreateSanction", mock.Anything).Return(1, nil)
+				repo.On("SetStatusRedis", int64(2), models.StatusBanned, time.Duration(0)).Return(nil)
+			},
+		},
+		{
+			name:     "suspension in the past",
+			sanction: &models.Sanction{UserId: 2, Kind: string(models.Suspend), Reason: "spam", ExpiresAt: &past},
+			code:     codes.InvalidArgument,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(admin)
+			},
+		},
+		{
+			name:     "not an admin",
+			sanction: &models.Sanction{UserId: 2, Kind: string(models.Ban), Reason: "spam"},
+			code:     codes.PermissionDenied,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(&models.User{Id: 1, Role: string(models.RoleUser)})
+			},
+		},
+		{
+			name:     "target is an admin",
+			sanction: &models.Sanction{UserId: 2, Kind: string(models.Ban), Reason: "spam"},
+			code:     codes.PermissionDenied,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(admin)
+				repo.On("GetById", int64(2)).Return(&models.User{Id: 2, Role: string(models.RoleAdmin)})
+			},
+		},
+		{
+			name:     "bad repository",
+			sanction: &models.Sanction{UserId: 2, Kind: string(models.Ban), Reason: "spam"},
+			code:     codes.Internal,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(admin)
+				repo.On("GetById", int64(2)).Return(&models.User{Id: 2, Role: string(models.RoleUser)})
+				repo.On("CreateSanction", mock.Anything).Return(-1, errors.New(""))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.repo()
+			t.Cleanup(func() {
+				repo.ExpectedCalls = nil
+				repo.Calls = nil
+			})
+			err := service.SanctionUser(1, tt.sanction)
+			assert.Equal(t, status.Code(err), tt.code)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetStatus(t *testing.T) {
+	log := logger.NewLogger(os.Stdout)
+	repo := new(mocks.MockAccountRepository)
+	service := NewService(&ServiceDeps{
+		Logger:     log,
+		Repository: repo,
+	})
+	suspendedUntil := time.Now().Add(time.Hour)
+	ended := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name   string
+		status models.UserStatus
+		code   codes.Code
+		repo   func()
+	}{
+		{
+			name:   "active",
+			status: models.StatusActive,
+			code:   codes.OK,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(&models.User{Id: 1, Status: string(models.StatusActive)})
+				repo.On("CacheActiveStatus", int64(1), activeStatusTTL).Return(nil)
+			},
+		},
+		{
+			name:   "suspension ended",
+			status: models.StatusActive,
+			code:   codes.OK,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(&models.User{
+					Id:             1,
+					Status:         string(models.StatusSuspended),
+					SuspendedUntil: &ended,
+				})
+				repo.On("CacheActiveStatus", int64(1), activeStatusTTL).Return(nil)
+			},
+		},
+		{
+			name:   "suspended",
+			status: models.StatusSuspended,
+			code:   codes.OK,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(&models.User{
+					Id:             1,
+					Status:         string(models.StatusSuspended),
+					SuspendedUntil: &suspendedUntil,
+				})
+				repo.On("SetStatusRedis", int64(1), models.StatusSuspended, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:   "banned",
+			status: models.StatusBanned,
+			code:   codes.OK,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(&models.User{Id: 1, Status: string(models.StatusBanned)})
+				repo.On("SetStatusRedis", int64(1), models.StatusBanned, time.Duration(0)).Return(nil)
+			},
+		},
+		{
+			name:   "user does not exist",
+			status: "",
+			code:   codes.NotFound,
+			repo: func() {
+				repo.On("GetById", int64(1)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.repo()
+			t.Cleanup(func() {
+				repo.ExpectedCalls = nil
+				repo.Calls = nil
+			})
+			res, err := service.GetStatus(1)
+			assert.Equal(t, res, tt.status)
+			assert.Equal(t, status.Code(err), tt.code)
+			repo.AssertExpectations(t)
+		})
+	}
+}
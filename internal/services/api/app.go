@@ -1,8 +1,14 @@
 package api
 
 import (
+	"context"
 	"flame/internal/config"
 	"flame/internal/services/api/handlers"
+	"flame/internal/services/api/middleware"
+	"flame/internal/services/api/ws"
+	"flame/pkg/db"
+	grpc_conn "flame/pkg/grpc-conn"
+	"flame/pkg/pb"
 	"github.com/go-chi/chi/v5"
 	"log/slog"
 	"net/http"
@@ -11,11 +17,13 @@ import (
 type AppDeps struct {
 	Config *config.Config
 	Logger *slog.Logger
+	Redis  *db.Redis
 	Mode   string
 }
 type App struct {
 	Config *config.Config
 	Logger *slog.Logger
+	Redis  *db.Redis
 	Mode   string
 }
 
@@ -23,6 +31,7 @@ func NewApp(deps *AppDeps) *App {
 	return &App{
 		Config: deps.Config,
 		Logger: deps.Logger,
+		Redis:  deps.Redis,
 		Mode:   deps.Mode,
 	}
 }
@@ -31,11 +40,32 @@ func (app *App) Run() error {
 	router := chi.NewRouter()
 
 	service := NewService(&ServiceDeps{})
+	accountConn, err := grpc_conn.NewClientConn(app.Config.Services.Account.Address)
+	if err != nil {
+		app.Logger.Error(err.Error(),
+			slog.String("Error location", "App.Run.grpc_conn.NewClientConn"),
+			slog.String("Account address", app.Config.Services.Account.Address),
+		)
+		return err
+	}
+	statuses := &middleware.Statuses{
+		Redis:    app.Redis,
+		Accounts: pb.NewAccountClient(accountConn),
+	}
+	hub := ws.NewHub(&ws.HubDeps{
+		Redis:  app.Redis,
+		Logger: app.Logger,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go hub.Run(ctx)
 	router.Route("/api", func(r chi.Router) {
 		api.InitHandlers(r, &api.HandlersDeps{
 			ApiService: service,
 			Logger:     app.Logger,
 			Config:     app.Config,
+			Hub:        hub,
+			Statuses:   statuses,
 		})
 	})
 
@@ -49,7 +79,7 @@ func (app *App) Run() error {
 		slog.String("Mode", app.Mode),
 	)
 	defer server.Close()
-	err := server.ListenAndServe()
+	err = server.ListenAndServe()
 	if err != nil {
 		return err
 	}
@@ -1,10 +1,12 @@
 package dto
 
+import "flame/pkg/geo"
+
 type AccountRegisterReq struct {
-	Name     string `json:"name" validate:"required,min=2,max=50"`
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=6,max=50"`
-	Location string `json:"location,omitempty"`
+	Name     string       `json:"name" validate:"required,min=2,max=50"`
+	Email    string       `json:"email" validate:"required,email"`
+	Password string       `json:"password" validate:"required,min=6,max=50"`
+	Location geo.Location `json:"location,omitempty" validate:"omitempty,location"`
 }
 
 type AccountRegisterRes struct {
@@ -12,9 +14,9 @@ type AccountRegisterRes struct {
 }
 
 type AccountLoginReq struct {
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=6,max=50"`
-	Location string `json:"location,omitempty"`
+	Email    string       `json:"email" validate:"required,email"`
+	Password string       `json:"password" validate:"required,min=6,max=50"`
+	Location geo.Location `json:"location,omitempty" validate:"omitempty,location"`
 }
 
 type AccountLoginRes struct {
@@ -38,16 +40,28 @@ type DeletePhotoReq struct {
 }
 
 type GetMatchingReq struct {
-	Location string `json:"location" validate:"required"`
+	Location geo.Location `json:"location" validate:"required,location"`
 }
 
 type UpdateLocation struct {
-	Location string `json:"location" validate:"required"`
+	Location geo.Location `json:"location" validate:"required,location"`
+}
+
+type SetTravelReq struct {
+	Location geo.Location `json:"location" validate:"required,location"`
+	StartsAt *string      `json:"starts_at,omitempty"`
+	EndsAt   string       `json:"ends_at" validate:"required"`
 }
 
 type AccountUpdatePreferencesReq struct {
-	Distance *int32  `json:"distance,omitempty"`
-	Age      *int32  `json:"age,omitempty"`
-	Gender   *string `json:"gender,omitempty"`
-	City     *string `json:"city,omitempty"`
+	Distance     *int32    `json:"distance,omitempty"`
+	AgeMin       *int32    `json:"age_min,omitempty"`
+	AgeMax       *int32    `json:"age_max,omitempty"`
+	InterestedIn *[]string `json:"interested_in,omitempty"`
+	City         *string   `json:"city,omitempty"`
+}
+
+type ReportReq struct {
+	Reason  string  `json:"reason" validate:"required,oneof=spam fake inappropriate harassment underage other"`
+	Comment *string `json:"comment,omitempty" validate:"omitempty,max=1000"`
 }
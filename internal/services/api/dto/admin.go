@@ -0,0 +1,14 @@
+package dto
+
+type ResolveReportReq struct {
+	Status string `json:"status" validate:"required,oneof=resolved dismissed"`
+}
+
+type BanReq struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+}
+
+type SuspendReq struct {
+	Reason string `json:"reason" validate:"required,max=500"`
+	Until  string `json:"until" validate:"required"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+type SendMessageReq struct {
+	Text string `json:"text" validate:"required,max=2000"`
+}
+
+type MarkReadReq struct {
+	MessageId int64 `json:"message_id" validate:"required,min=1"`
+}
+
+type MarkReadRes struct {
+	Count int64 `json:"count"`
+}
@@ -1,5 +1,6 @@
 package dto
 
 type ErrorRes struct {
-	Error string `json:"error"`
+	Error  string            `json:"error"`
+	Fields map[string]string `json:"fields,omitempty"`
 }
@@ -1,10 +1,64 @@
 package dto
 
+import "flame/internal/models"
+
 type CreateSwipesReq struct {
-	UserId int64 `json:"user_id" validate:"required,number"`
-	IsLike *bool `json:"is_like" validate:"required,boolean"`
+	UserId int64   `json:"user_id" validate:"required,number"`
+	Kind   *string `json:"kind,omitempty" validate:"omitempty,oneof=dislike like super_like"`
+	IsLike *bool   `json:"is_like,omitempty" validate:"required_without=Kind"`
+}
+
+func (r *CreateSwipesReq) SwipeKind() models.SwipeKind {
+	if r.Kind != nil {
+		return models.SwipeKind(*r.Kind)
+	}
+	if *r.IsLike {
+		return models.SwipeLike
+	}
+	return models.SwipeDislike
 }
 
 type GetUnreadSwipes struct {
 	Users []int64 `json:"users"`
+	Count int64   `json:"count"`
+}
+
+type CountUnreadSwipes struct {
+	Count int64 `json:"count"`
+}
+
+type CreateSwipesRes struct {
+	IsMatch bool   `json:"is_match"`
+	MatchId *int64 `json:"match_id,omitempty"`
+}
+
+type Match struct {
+	Id        int64  `json:"id"`
+	UserId    int64  `json:"user_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type GetMatchesRes struct {
+	Matches []Match `json:"matches"`
+}
+
+type RewindRes struct {
+	UserId int64 `json:"user_id"`
+}
+
+type Quota struct {
+	Limit     int64   `json:"limit"`
+	Used      int64   `json:"used"`
+	Remaining int64   `json:"remaining"`
+	ResetAt   *string `json:"reset_at,omitempty"`
+}
+
+type GetQuotaRes struct {
+	Likes      Quota `json:"likes"`
+	SuperLikes Quota `json:"super_likes"`
+}
+
+type QuotaErrorRes struct {
+	Error   string `json:"error"`
+	ResetAt string `json:"reset_at"`
 }
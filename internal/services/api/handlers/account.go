@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,6 +29,7 @@ type AccountHandlerDeps struct {
 	Logger     *slog.Logger
 	Config     *config.Config
 	ApiService interfaces.ApiService
+	Statuses   *middleware.Statuses
 }
 type AccountHandler struct {
 	Logger        *slog.Logger
@@ -71,13 +73,21 @@ func NewAccountHandler(router chi.Router, deps *AccountHandlerDeps) error {
 		r.Get("/get-tokens", handler.GetTokens())
 	})
 	router.Route("/user", func(r chi.Router) {
-		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt))
+		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt, deps.Statuses))
 		r.Put("/profile", handler.UpdateProfile())
 		r.Get("/profile", handler.GetProfile())
 		r.Put("/photo", handler.UploadPhoto())
 		r.Delete("/photo", handler.DeletePhoto())
 		r.Put("/location", handler.UpdateLocation())
 		r.Put("/prefer", handler.UpdatePreferences())
+		r.Put("/travel", handler.SetTravel())
+		r.Get("/travel", handler.GetTravel())
+		r.Delete("/travel", handler.EndTravel())
+	})
+	router.Route("/users", func(r chi.Router) {
+		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt, deps.Statuses))
+		r.Post("/{id}/block", handler.Block())
+		r.Post("/{id}/report", handler.Report())
 	})
 	return nil
 }
@@ -87,7 +97,8 @@ func (handler *AccountHandler) Register() http.HandlerFunc {
 		body, err := req.HandleBody[dto.AccountRegisterReq](r)
 		if err != nil {
 			res.Json(w, dto.ErrorRes{
-				Error: err.Error(),
+				Error:  err.Error(),
+				Fields: req.FieldErrors(err),
 			}, http.StatusBadRequest)
 			return
 		}
@@ -95,7 +106,7 @@ func (handler *AccountHandler) Register() http.HandlerFunc {
 			Email:    body.Email,
 			Password: body.Password,
 			Name:     body.Name,
-			Location: body.Location,
+			Location: body.Location.String(),
 		})
 		if err != nil {
 			msg, code := http_errors.HandleError(err)
@@ -115,14 +126,15 @@ func (handler *AccountHandler) Login() http.HandlerFunc {
 		body, err := req.HandleBody[dto.AccountLoginReq](r)
 		if err != nil {
 			res.Json(w, dto.ErrorRes{
-				Error: err.Error(),
+				Error:  err.Error(),
+				Fields: req.FieldErrors(err),
 			}, http.StatusBadRequest)
 			return
 		}
 		response, err := handler.AccountClient.Login(context.Background(), &pb.LoginReq{
 			Email:    body.Email,
 			Password: body.Password,
-			Location: body.Location,
+			Location: body.Location.String(),
 		})
 		if err != nil {
 			msg, code := http_errors.HandleError(err)
@@ -321,12 +333,13 @@ func (handler *AccountHandler) UpdateLocation() http.HandlerFunc {
 		body, err := req.HandleBody[dto.UpdateLocation](r)
 		if err != nil {
 			res.Json(w, dto.ErrorRes{
-				Error: http.StatusText(http.StatusBadRequest),
+				Error:  err.Error(),
+				Fields: req.FieldErrors(err),
 			}, http.StatusBadRequest)
 			return
 		}
 		_, err = handler.AccountClient.UpdateLocation(context.Background(), &pb.UpdateLocationReq{
-			Location: body.Location,
+			Location: body.Location.String(),
 			UserId:   id,
 		})
 		if err != nil {
@@ -340,6 +353,78 @@ func (handler *AccountHandler) UpdateLocation() http.HandlerFunc {
 	}
 }
 
+func (handler *AccountHandler) SetTravel() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.Context().Value("authData").(middleware.AuthData).Id
+		body, err := req.HandleBody[dto.SetTravelReq](r)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error:  err.Error(),
+				Fields: req.FieldErrors(err),
+			}, http.StatusBadRequest)
+			return
+		}
+		_, err = handler.AccountClient.SetTravel(context.Background(), &pb.SetTravelReq{
+			UserId:   id,
+			Location: body.Location.String(),
+			StartsAt: body.StartsAt,
+			EndsAt:   body.EndsAt,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, nil, http.StatusOK)
+	}
+}
+
+func (handler *AccountHandler) GetTravel() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.Context().Value("authData").(middleware.AuthData).Id
+		response, err := handler.AccountClient.GetTravel(context.Background(), &pb.GetTravelReq{
+			UserId: id,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		opts := protojson.MarshalOptions{
+			EmitUnpopulated: true,
+		}
+		data, err := opts.Marshal(response)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusInternalServerError),
+			}, http.StatusInternalServerError)
+			return
+		}
+		res.ProtoJson(w, data, http.StatusOK)
+	}
+}
+
+func (handler *AccountHandler) EndTravel() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.Context().Value("authData").(middleware.AuthData).Id
+		_, err := handler.AccountClient.EndTravel(context.Background(), &pb.EndTravelReq{
+			UserId: id,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, nil, http.StatusOK)
+	}
+}
+
 func (handler *AccountHandler) UpdatePreferences() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		userId := r.Context().Value("authData").(middleware.AuthData).Id
@@ -351,12 +436,43 @@ func (handler *AccountHandler) UpdatePreferences() http.HandlerFunc {
 			return
 		}
 		ctx := context.Background()
-		_, err = handler.AccountClient.UpdatePreferences(ctx, &pb.UpdatePreferencesReq{
+		request := &pb.UpdatePreferencesReq{
 			UserId:   userId,
 			Distance: body.Distance,
-			Age:      body.Age,
-			Gender:   body.Gender,
+			AgeMin:   body.AgeMin,
+			AgeMax:   body.AgeMax,
 			City:     body.City,
+		}
+		if body.InterestedIn != nil {
+			request.InterestedIn = &pb.InterestedIn{
+				Genders: *body.InterestedIn,
+			}
+		}
+		_, err = handler.AccountClient.UpdatePreferences(ctx, request)
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, nil, http.StatusOK)
+	}
+}
+
+func (handler *AccountHandler) Block() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		targetId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		_, err = handler.AccountClient.Block(context.Background(), &pb.BlockReq{
+			UserId:   userId,
+			TargetId: targetId,
 		})
 		if err != nil {
 			mes, code := http_errors.HandleError(err)
@@ -368,3 +484,37 @@ func (handler *AccountHandler) UpdatePreferences() http.HandlerFunc {
 		res.Json(w, nil, http.StatusOK)
 	}
 }
+
+func (handler *AccountHandler) Report() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		targetId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		body, err := req.HandleBody[dto.ReportReq](r)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: err.Error(),
+			}, http.StatusBadRequest)
+			return
+		}
+		_, err = handler.AccountClient.Report(context.Background(), &pb.ReportReq{
+			UserId:   userId,
+			TargetId: targetId,
+			Reason:   body.Reason,
+			Comment:  body.Comment,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, nil, http.StatusCreated)
+	}
+}
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"context"
+	"flame/internal/config"
+	"flame/internal/models"
+	"flame/internal/services/api/dto"
+	"flame/internal/services/api/middleware"
+	http_errors "flame/pkg/errors"
+	grpc_conn "flame/pkg/grpc-conn"
+	"flame/pkg/pb"
+	"flame/pkg/req"
+	"flame/pkg/res"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/go-chi/chi/v5"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type AdminHandlerDeps struct {
+	Logger   *slog.Logger
+	Config   *config.Config
+	Statuses *middleware.Statuses
+}
+type AdminHandler struct {
+	Logger        *slog.Logger
+	Config        *config.Config
+	AccountClient pb.AccountClient
+	S3Client      *s3.Client
+}
+
+func NewAdminHandler(router chi.Router, deps *AdminHandlerDeps) error {
+	accountConn, err := grpc_conn.NewClientConn(deps.Config.Services.Account.Address)
+	if err != nil {
+		deps.Logger.Error(err.Error(),
+			slog.String("Error location", "NewAdminHandler.grpc_conn.NewClientConn"),
+			slog.String("Account address", deps.Config.Services.Account.Address),
+		)
+		return err
+	}
+	s3Client, err := config.NewS3Client()
+	if err != nil {
+		deps.Logger.Error(err.Error(),
+			slog.String("Error location", "NewAdminHandler.config.NewS3Client"),
+		)
+		return err
+	}
+	handler := &AdminHandler{
+		Logger:        deps.Logger,
+		Config:        deps.Config,
+		AccountClient: pb.NewAccountClient(accountConn),
+		S3Client:      s3Client,
+	}
+	router.Route("/admin", func(r chi.Router) {
+		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt, deps.Statuses))
+		r.Use(middleware.HasRole(string(models.RoleAdmin)))
+		r.Get("/reports", handler.GetReports())
+		r.Put("/reports/{id}", handler.ResolveReport())
+		r.Get("/users/{id}", handler.GetReportedUser())
+		r.Post("/users/{id}/ban", handler.Ban())
+		r.Post("/users/{id}/suspend", handler.Suspend())
+		r.Delete("/photos/{id}", handler.RemovePhoto())
+	})
+	return nil
+}
+
+func (handler *AdminHandler) GetReports() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		adminId := r.Context().Value("authData").(middleware.AuthData).Id
+		request := &pb.GetReportsReq{
+			AdminId: adminId,
+		}
+		if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+			cursor, err := strconv.ParseInt(cursorStr, 10, 64)
+			if err != nil {
+				res.Json(w, dto.ErrorRes{
+					Error: http.StatusText(http.StatusBadRequest),
+				}, http.StatusBadRequest)
+				return
+			}
+			request.Cursor = &cursor
+		}
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			limit, err := strconv.ParseInt(limitStr, 10, 32)
+			if err != nil {
+				res.Json(w, dto.ErrorRes{
+					Error: http.StatusText(http.StatusBadRequest),
+				}, http.StatusBadRequest)
+				return
+			}
+			request.Limit = int32(limit)
+		}
+		response, err := handler.AccountClient.GetReports(context.Background(), request)
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		writeProto(w, response, http.StatusOK)
+	}
+}
+
+func (handler *AdminHandler) ResolveReport() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		adminId := r.Context().Value("authData").(middleware.AuthData).Id
+		reportId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		body, err := req.HandleBody[dto.ResolveReportReq](r)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: err.Error(),
+			}, http.StatusBadRequest)
+			return
+		}
+		_, err = handler.AccountClient.ResolveReport(context.Background(), &pb.ResolveReportReq{
+			AdminId:  adminId,
+			ReportId: reportId,
+			Status:   body.Status,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, nil, http.StatusOK)
+	}
+}
+
+func (handler *AdminHandler) GetReportedUser() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		adminId := r.Context().Value("authData").(middleware.AuthData).Id
+		userId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		response, err := handler.AccountClient.GetReportedUser(context.Background(), &pb.GetReportedUserReq{
+			AdminId: adminId,
+			UserId:  userId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		writeProto(w, response, http.StatusOK)
+	}
+}
+
+func (handler *AdminHandler) Ban() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := req.HandleBody[dto.BanReq](r)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: err.Error(),
+			}, http.StatusBadRequest)
+			return
+		}
+		handler.sanction(w, r, &pb.SanctionUserReq{
+			Kind:   string(models.Ban),
+			Reason: body.Reason,
+		})
+	}
+}
+
+func (handler *AdminHandler) Suspend() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := req.HandleBody[dto.SuspendReq](r)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: err.Error(),
+			}, http.StatusBadRequest)
+			return
+		}
+		handler.sanction(w, r, &pb.SanctionUserReq{
+			Kind:      string(models.Suspend),
+			Reason:    body.Reason,
+			ExpiresAt: &body.Until,
+		})
+	}
+}
+
+func (handler *AdminHandler) sanction(w http.ResponseWriter, r *http.Request, request *pb.SanctionUserReq) {
+	userId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		res.Json(w, dto.ErrorRes{
+			Error: http.StatusText(http.StatusBadRequest),
+		}, http.StatusBadRequest)
+		return
+	}
+	request.AdminId = r.Context().Value("authData").(middleware.AuthData).Id
+	request.UserId = userId
+	_, err = handler.AccountClient.SanctionUser(context.Background(), request)
+	if err != nil {
+		mes, code := http_errors.HandleError(err)
+		res.Json(w, dto.ErrorRes{
+			Error: mes,
+		}, code)
+		return
+	}
+	res.Json(w, nil, http.StatusCreated)
+}
+
+func (handler *AdminHandler) RemovePhoto() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		adminId := r.Context().Value("authData").(middleware.AuthData).Id
+		photoId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		response, err := handler.AccountClient.RemovePhoto(context.Background(), &pb.RemovePhotoReq{
+			AdminId: adminId,
+			PhotoId: photoId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		filename := strings.Split(response.PhotoUrl, "/")
+		_, err = handler.S3Client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
+			Bucket: &handler.Config.S3.Bucket,
+			Key:    &filename[len(filename)-1],
+		})
+		if err != nil {
+			handler.Logger.Error(err.Error(),
+				slog.String("Error location", "AdminHandler.RemovePhoto.S3Client.DeleteObject"),
+				slog.String("PhotoUrl", response.PhotoUrl),
+			)
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusInternalServerError),
+			}, http.StatusInternalServerError)
+			return
+		}
+		res.Json(w, nil, http.StatusOK)
+	}
+}
@@ -0,0 +1,217 @@
+package api
+
+import (
+	"context"
+	"flame/internal/config"
+	"flame/internal/services/api/dto"
+	"flame/internal/services/api/middleware"
+	"flame/internal/services/api/ws"
+	http_errors "flame/pkg/errors"
+	grpc_conn "flame/pkg/grpc-conn"
+	"flame/pkg/pb"
+	"flame/pkg/req"
+	"flame/pkg/res"
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type ChatHandlerDeps struct {
+	Logger   *slog.Logger
+	Config   *config.Config
+	Hub      *ws.Hub
+	Statuses *middleware.Statuses
+}
+type ChatHandler struct {
+	Logger     *slog.Logger
+	Config     *config.Config
+	Hub        *ws.Hub
+	ChatClient pb.ChatClient
+}
+
+func NewChatHandler(router chi.Router, deps *ChatHandlerDeps) error {
+	chatConn, err := grpc_conn.NewClientConn(deps.Config.Services.Chat.Address)
+	if err != nil {
+		deps.Logger.Error(err.Error(),
+			slog.String("Error location", "NewChatHandler.grpc_conn.NewClientConn"),
+			slog.String("Chat address", deps.Config.Services.Chat.Address),
+		)
+		return err
+	}
+	handler := &ChatHandler{
+		Logger:     deps.Logger,
+		Config:     deps.Config,
+		Hub:        deps.Hub,
+		ChatClient: pb.NewChatClient(chatConn),
+	}
+	router.Route("/chats", func(r chi.Router) {
+		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt, deps.Statuses))
+		r.Get("/", handler.GetConversations())
+		r.Get("/{id}/messages", handler.GetMessages())
+		r.Post("/{id}/messages", handler.SendMessage())
+		r.Put("/{id}/read", handler.MarkRead())
+	})
+	return nil
+}
+
+func (handler *ChatHandler) GetConversations() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		response, err := handler.ChatClient.GetConversations(context.Background(), &pb.GetConversationsReq{
+			UserId: userId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		writeProto(w, response, http.StatusOK)
+	}
+}
+
+func (handler *ChatHandler) GetMessages() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		partnerId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		request := &pb.GetMessagesReq{
+			UserId:    userId,
+			PartnerId: partnerId,
+		}
+		if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+			cursor, err := strconv.ParseInt(cursorStr, 10, 64)
+			if err != nil {
+				res.Json(w, dto.ErrorRes{
+					Error: http.StatusText(http.StatusBadRequest),
+				}, http.StatusBadRequest)
+				return
+			}
+			request.Cursor = &cursor
+		}
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			limit, err := strconv.ParseInt(limitStr, 10, 32)
+			if err != nil {
+				res.Json(w, dto.ErrorRes{
+					Error: http.StatusText(http.StatusBadRequest),
+				}, http.StatusBadRequest)
+				return
+			}
+			request.Limit = int32(limit)
+		}
+		response, err := handler.ChatClient.GetMessages(context.Background(), request)
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		writeProto(w, response, http.StatusOK)
+	}
+}
+
+func (handler *ChatHandler) SendMessage() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		recipientId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		body, err := req.HandleBody[dto.SendMessageReq](r)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: err.Error(),
+			}, http.StatusBadRequest)
+			return
+		}
+		response, err := handler.ChatClient.SendMessage(context.Background(), &pb.SendMessageReq{
+			SenderId:    userId,
+			RecipientId: recipientId,
+			Text:        body.Text,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		err = publishMessage(context.Background(), handler.Hub, response.Message, recipientId)
+		if err != nil {
+			handler.Logger.Error(err.Error(),
+				slog.String("Error location", "ChatHandler.SendMessage.publishMessage"),
+				slog.Int64("RecipientId", recipientId),
+			)
+		}
+		writeProto(w, response, http.StatusCreated)
+	}
+}
+
+func (handler *ChatHandler) MarkRead() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		partnerId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		body, err := req.HandleBody[dto.MarkReadReq](r)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: err.Error(),
+			}, http.StatusBadRequest)
+			return
+		}
+		response, err := handler.ChatClient.MarkRead(context.Background(), &pb.MarkReadReq{
+			UserId:    userId,
+			PartnerId: partnerId,
+			MessageId: body.MessageId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		err = publishRead(context.Background(), handler.Hub, userId, partnerId, body.MessageId)
+		if err != nil {
+			handler.Logger.Error(err.Error(),
+				slog.String("Error location", "ChatHandler.MarkRead.publishRead"),
+				slog.Int64("PartnerId", partnerId),
+			)
+		}
+		res.Json(w, dto.MarkReadRes{
+			Count: response.Count,
+		}, http.StatusOK)
+	}
+}
+
+func writeProto(w http.ResponseWriter, message proto.Message, code int) {
+	opts := protojson.MarshalOptions{
+		EmitUnpopulated: true,
+	}
+	data, err := opts.Marshal(message)
+	if err != nil {
+		res.Json(w, dto.ErrorRes{
+			Error: http.StatusText(http.StatusInternalServerError),
+		}, http.StatusInternalServerError)
+		return
+	}
+	res.ProtoJson(w, data, code)
+}
@@ -3,6 +3,8 @@ package api
 import (
 	"flame/internal/config"
 	"flame/internal/interfaces"
+	"flame/internal/services/api/middleware"
+	"flame/internal/services/api/ws"
 	"github.com/go-chi/chi/v5"
 	"log/slog"
 )
@@ -11,6 +13,8 @@ type HandlersDeps struct {
 	ApiService interfaces.ApiService
 	Logger     *slog.Logger
 	Config     *config.Config
+	Hub        *ws.Hub
+	Statuses   *middleware.Statuses
 }
 
 func InitHandlers(router chi.Router, deps *HandlersDeps) {
@@ -18,13 +22,33 @@ func InitHandlers(router chi.Router, deps *HandlersDeps) {
 		Logger:     deps.Logger,
 		Config:     deps.Config,
 		ApiService: deps.ApiService,
+		Statuses:   deps.Statuses,
 	})
 	_ = NewMatchingHandler(router, &MatchingHandlerDeps{
-		Logger: deps.Logger,
-		Config: deps.Config,
+		Logger:   deps.Logger,
+		Config:   deps.Config,
+		Statuses: deps.Statuses,
 	})
 	_ = NewSwipesHandler(router, &SwipesHandlerDeps{
-		Logger: deps.Logger,
-		Config: deps.Config,
+		Logger:   deps.Logger,
+		Config:   deps.Config,
+		Statuses: deps.Statuses,
+	})
+	_ = NewChatHandler(router, &ChatHandlerDeps{
+		Logger:   deps.Logger,
+		Config:   deps.Config,
+		Hub:      deps.Hub,
+		Statuses: deps.Statuses,
+	})
+	_ = NewAdminHandler(router, &AdminHandlerDeps{
+		Logger:   deps.Logger,
+		Config:   deps.Config,
+		Statuses: deps.Statuses,
+	})
+	_ = NewWsHandler(router, &WsHandlerDeps{
+		Logger:   deps.Logger,
+		Config:   deps.Config,
+		Hub:      deps.Hub,
+		Statuses: deps.Statuses,
 	})
 }
@@ -14,11 +14,13 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"log/slog"
 	"net/http"
+	"strconv"
 )
 
 type MatchingHandlerDeps struct {
-	Logger *slog.Logger
-	Config *config.Config
+	Logger   *slog.Logger
+	Config   *config.Config
+	Statuses *middleware.Statuses
 }
 type MatchingHandler struct {
 	Logger      *slog.Logger
@@ -48,9 +50,13 @@ func NewMatchingHandler(router chi.Router, deps *MatchingHandlerDeps) error {
 		MatchClient: accountClient,
 	}
 	router.Route("/match", func(r chi.Router) {
-		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt))
+		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt, deps.Statuses))
 		r.Get("/", handler.getMatchingUsers())
 	})
+	router.Route("/likes", func(r chi.Router) {
+		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt, deps.Statuses))
+		r.Get("/", handler.getLikes())
+	})
 	return nil
 	
 }
@@ -61,14 +67,66 @@ func (handler *MatchingHandler) getMatchingUsers() http.HandlerFunc {
 		body, err := req.HandleBody[dto.GetMatchingReq](r)
 		if err != nil {
 			res.Json(w, dto.ErrorRes{
-				Error: http.StatusText(http.StatusBadRequest),
+				Error:  err.Error(),
+				Fields: req.FieldErrors(err),
 			}, http.StatusBadRequest)
 			return
 		}
-		response, err := handler.MatchClient.GetMatchingUsers(context.Background(), &pb.GetMatchingUsersReq{
-			Id:       id,
-			Location: body.Location,
-		})
+		request := &pb.GetMatchingUsersReq{
+			Id:        id,
+			Location:  body.Location.String(),
+			PageToken: r.URL.Query().Get("page_token"),
+		}
+		if pageSizeStr := r.URL.Query().Get("page_size"); pageSizeStr != "" {
+			pageSize, err := strconv.ParseInt(pageSizeStr, 10, 32)
+			if err != nil {
+				res.Json(w, dto.ErrorRes{
+					Error: http.StatusText(http.StatusBadRequest),
+				}, http.StatusBadRequest)
+				return
+			}
+			request.PageSize = int32(pageSize)
+		}
+		response, err := handler.MatchClient.GetMatchingUsers(context.Background(), request)
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		opts := protojson.MarshalOptions{
+			EmitUnpopulated: true,
+		}
+		data, err := opts.Marshal(response)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusInternalServerError),
+			}, http.StatusInternalServerError)
+			return
+		}
+		res.ProtoJson(w, data, http.StatusOK)
+	}
+}
+
+func (handler *MatchingHandler) getLikes() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.Context().Value("authData").(middleware.AuthData).Id
+		request := &pb.GetLikesReq{
+			UserId:    id,
+			PageToken: r.URL.Query().Get("page_token"),
+		}
+		if pageSizeStr := r.URL.Query().Get("page_size"); pageSizeStr != "" {
+			pageSize, err := strconv.ParseInt(pageSizeStr, 10, 32)
+			if err != nil {
+				res.Json(w, dto.ErrorRes{
+					Error: http.StatusText(http.StatusBadRequest),
+				}, http.StatusBadRequest)
+				return
+			}
+			request.PageSize = int32(pageSize)
+		}
+		response, err := handler.MatchClient.GetLikes(context.Background(), request)
 		if err != nil {
 			mes, code := http_errors.HandleError(err)
 			res.Json(w, dto.ErrorRes{
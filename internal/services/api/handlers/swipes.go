@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"flame/internal/config"
+	"flame/internal/mappers"
 	"flame/internal/services/api/dto"
 	"flame/internal/services/api/middleware"
 	http_errors "flame/pkg/errors"
@@ -12,12 +13,16 @@ import (
 	"flame/pkg/res"
 	"github.com/go-chi/chi/v5"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 type SwipesHandlerDeps struct {
-	Logger *slog.Logger
-	Config *config.Config
+	Logger   *slog.Logger
+	Config   *config.Config
+	Statuses *middleware.Statuses
 }
 type SwipesHandler struct {
 	Logger       *slog.Logger
@@ -47,9 +52,18 @@ func NewSwipesHandler(router chi.Router, deps *SwipesHandlerDeps) error {
 		SwipesClient: swipesClient,
 	}
 	router.Route("/swipes", func(r chi.Router) {
-		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt))
+		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt, deps.Statuses))
 		r.Post("/", handler.CreateSwipe())
 		r.Get("/unread", handler.GetUnreadSwipes())
+		r.Get("/unread/count", handler.CountUnreadSwipes())
+		r.Post("/rewind", handler.Rewind())
+		r.Get("/quota", handler.GetQuota())
+	})
+	router.Route("/matches", func(r chi.Router) {
+		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt, deps.Statuses))
+		r.Get("/", handler.GetMatches())
+		r.Get("/{id}", handler.GetMatch())
+		r.Delete("/{id}", handler.Unmatch())
 	})
 	return nil
 
@@ -65,19 +79,30 @@ func (handler *SwipesHandler) CreateSwipe() http.HandlerFunc {
 			}, http.StatusBadRequest)
 			return
 		}
-		_, err = handler.SwipesClient.CreateOrUpdateSwipe(context.Background(), &pb.CreateOrUpdateSwipeReq{
+		response, err := handler.SwipesClient.CreateOrUpdateSwipe(context.Background(), &pb.CreateOrUpdateSwipeReq{
 			UserId1: userId1,
 			UserId2: body.UserId,
-			IsLike:  *body.IsLike,
+			Kind:    mappers.FromModelSwipeKindToGrpc(body.SwipeKind()),
 		})
 		if err != nil {
 			mes, code := http_errors.HandleError(err)
+			if resetAt, ok := http_errors.ResetAt(err); ok {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(time.Until(resetAt).Seconds()))))
+				res.Json(w, dto.QuotaErrorRes{
+					Error:   mes,
+					ResetAt: resetAt.Format(time.RFC3339),
+				}, code)
+				return
+			}
 			res.Json(w, dto.ErrorRes{
 				Error: mes,
 			}, code)
 			return
 		}
-		res.Json(w, nil, http.StatusCreated)
+		res.Json(w, dto.CreateSwipesRes{
+			IsMatch: response.IsMatch,
+			MatchId: response.MatchId,
+		}, http.StatusCreated)
 	}
 }
 
@@ -96,6 +121,155 @@ func (handler *SwipesHandler) GetUnreadSwipes() http.HandlerFunc {
 		}
 		res.Json(w, dto.GetUnreadSwipes{
 			Users: response.UserIds,
+			Count: response.Count,
+		}, http.StatusOK)
+	}
+}
+
+func (handler *SwipesHandler) CountUnreadSwipes() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		response, err := handler.SwipesClient.CountUnreadSwipes(context.Background(), &pb.CountUnreadSwipesReq{
+			UserId: userId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, dto.CountUnreadSwipes{
+			Count: response.Count,
+		}, http.StatusOK)
+	}
+}
+
+func (handler *SwipesHandler) GetMatches() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		response, err := handler.SwipesClient.GetMatches(context.Background(), &pb.GetMatchesReq{
+			UserId: userId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		matches := make([]dto.Match, len(response.Matches))
+		for i, m := range response.Matches {
+			matches[i] = dto.Match{
+				Id:        m.Id,
+				UserId:    m.UserId,
+				CreatedAt: m.CreatedAt,
+			}
+		}
+		res.Json(w, dto.GetMatchesRes{
+			Matches: matches,
+		}, http.StatusOK)
+	}
+}
+
+func (handler *SwipesHandler) GetMatch() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		matchId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		response, err := handler.SwipesClient.GetMatch(context.Background(), &pb.GetMatchReq{
+			UserId:  userId,
+			MatchId: matchId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, dto.Match{
+			Id:        response.Match.Id,
+			UserId:    response.Match.UserId,
+			CreatedAt: response.Match.CreatedAt,
 		}, http.StatusOK)
 	}
 }
+
+func (handler *SwipesHandler) Unmatch() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		matchId, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+		if err != nil {
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusBadRequest),
+			}, http.StatusBadRequest)
+			return
+		}
+		_, err = handler.SwipesClient.Unmatch(context.Background(), &pb.UnmatchReq{
+			UserId:  userId,
+			MatchId: matchId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, nil, http.StatusOK)
+	}
+}
+
+func (handler *SwipesHandler) Rewind() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		response, err := handler.SwipesClient.Rewind(context.Background(), &pb.RewindReq{
+			UserId: userId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, dto.RewindRes{
+			UserId: response.UserId,
+		}, http.StatusOK)
+	}
+}
+
+func (handler *SwipesHandler) GetQuota() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		response, err := handler.SwipesClient.GetQuota(context.Background(), &pb.GetQuotaReq{
+			UserId: userId,
+		})
+		if err != nil {
+			mes, code := http_errors.HandleError(err)
+			res.Json(w, dto.ErrorRes{
+				Error: mes,
+			}, code)
+			return
+		}
+		res.Json(w, dto.GetQuotaRes{
+			Likes:      quotaToDto(response.Likes),
+			SuperLikes: quotaToDto(response.SuperLikes),
+		}, http.StatusOK)
+	}
+}
+
+func quotaToDto(quota *pb.Quota) dto.Quota {
+	return dto.Quota{
+		Limit:     quota.GetLimit(),
+		Used:      quota.GetUsed(),
+		Remaining: quota.GetRemaining(),
+		ResetAt:   quota.ResetAt,
+	}
+}
@@ -0,0 +1,211 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"flame/internal/config"
+	"flame/internal/services/api/dto"
+	"flame/internal/services/api/middleware"
+	"flame/internal/services/api/ws"
+	http_errors "flame/pkg/errors"
+	grpc_conn "flame/pkg/grpc-conn"
+	"flame/pkg/pb"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"log/slog"
+	"net/http"
+)
+
+type WsHandlerDeps struct {
+	Logger   *slog.Logger
+	Config   *config.Config
+	Hub      *ws.Hub
+	Statuses *middleware.Statuses
+}
+type WsHandler struct {
+	Logger       *slog.Logger
+	Config       *config.Config
+	Hub          *ws.Hub
+	ChatClient   pb.ChatClient
+	SwipesClient pb.SwipesClient
+	upgrader     websocket.Upgrader
+}
+
+func NewWsHandler(router chi.Router, deps *WsHandlerDeps) error {
+	chatConn, err := grpc_conn.NewClientConn(deps.Config.Services.Chat.Address)
+	if err != nil {
+		deps.Logger.Error(err.Error(),
+			slog.String("Error location", "NewWsHandler.grpc_conn.NewClientConn"),
+			slog.String("Chat address", deps.Config.Services.Chat.Address),
+		)
+		return err
+	}
+	swipesConn, err := grpc_conn.NewClientConn(deps.Config.Services.Swipes.Address)
+	if err != nil {
+		deps.Logger.Error(err.Error(),
+			slog.String("Error location", "NewWsHandler.grpc_conn.NewClientConn"),
+			slog.String("Swipes address", deps.Config.Services.Swipes.Address),
+		)
+		return err
+	}
+	handler := &WsHandler{
+		Logger:       deps.Logger,
+		Config:       deps.Config,
+		Hub:          deps.Hub,
+		ChatClient:   pb.NewChatClient(chatConn),
+		SwipesClient: pb.NewSwipesClient(swipesConn),
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     ws.CheckOrigin(deps.Config.Cors.AllowedOrigins),
+		},
+	}
+	router.Route("/ws", func(r chi.Router) {
+		r.Use(middleware.QueryToken)
+		r.Use(middleware.IsAuthed(handler.Config.Auth.Jwt, deps.Statuses))
+		r.Get("/", handler.Connect())
+	})
+	return nil
+}
+
+func (handler *WsHandler) Connect() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userId := r.Context().Value("authData").(middleware.AuthData).Id
+		conn, err := handler.upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			handler.Logger.Error(err.Error(),
+				slog.String("Error location", "WsHandler.upgrader.Upgrade"),
+				slog.Int64("UserId", userId),
+			)
+			return
+		}
+		client := ws.NewClient(handler.Hub, conn, userId)
+		go client.WritePump()
+		client.ReadPump(func(event ws.IncomingEvent) {
+			handler.handleEvent(client, event)
+		})
+	}
+}
+
+func (handler *WsHandler) handleEvent(client *ws.Client, event ws.IncomingEvent) {
+	ctx := context.Background()
+	var err error
+	switch event.Type {
+	case ws.EventMessage:
+		err = handler.sendMessage(ctx, client.UserId, event)
+	case ws.EventTyping:
+		err = handler.typing(ctx, client.UserId, event)
+	case ws.EventRead:
+		err = handler.markRead(ctx, client.UserId, event)
+	default:
+		client.Reply(newErrorEvent(http.StatusText(http.StatusBadRequest)))
+		return
+	}
+	if err != nil {
+		mes, _ := http_errors.HandleError(err)
+		if mes == "" {
+			mes = http.StatusText(http.StatusInternalServerError)
+		}
+		client.Reply(newErrorEvent(mes))
+	}
+}
+
+func (handler *WsHandler) sendMessage(ctx context.Context, userId int64, event ws.IncomingEvent) error {
+	response, err := handler.ChatClient.SendMessage(ctx, &pb.SendMessageReq{
+		SenderId:    userId,
+		RecipientId: event.To,
+		Text:        event.Text,
+	})
+	if err != nil {
+		return err
+	}
+	return publishMessage(ctx, handler.Hub, response.Message, event.To)
+}
+
+func (handler *WsHandler) typing(ctx context.Context, userId int64, event ws.IncomingEvent) error {
+	response, err := handler.SwipesClient.IsMatched(ctx, &pb.IsMatchedReq{
+		UserId1: userId,
+		UserId2: event.To,
+	})
+	if err != nil {
+		return err
+	}
+	if !response.IsMatched {
+		return nil
+	}
+	return handler.Hub.Publish(ctx, event.To, ws.Event{
+		Type: ws.EventTyping,
+		From: userId,
+		To:   event.To,
+	})
+}
+
+func (handler *WsHandler) markRead(ctx context.Context, userId int64, event ws.IncomingEvent) error {
+	_, err := handler.ChatClient.MarkRead(ctx, &pb.MarkReadReq{
+		UserId:    userId,
+		PartnerId: event.To,
+		MessageId: event.MessageId,
+	})
+	if err != nil {
+		return err
+	}
+	return publishRead(ctx, handler.Hub, userId, event.To, event.MessageId)
+}
+
+// publishMessage fans a new message out to every device of both participants.
+func publishMessage(ctx context.Context, hub *ws.Hub, message *pb.ChatMessage, recipientId int64) error {
+	// Same shape as the REST chat endpoints.
+	data, err := protojson.MarshalOptions{
+		EmitUnpopulated: true,
+	}.Marshal(message)
+	if err != nil {
+		return err
+	}
+	event := ws.Event{
+		Type: ws.EventMessage,
+		From: message.SenderId,
+		To:   recipientId,
+		Data: data,
+	}
+	for _, userId := range []int64{recipientId, message.SenderId} {
+		err = hub.Publish(ctx, userId, event)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishRead notifies the partner and the reader's other devices.
+func publishRead(ctx context.Context, hub *ws.Hub, readerId, partnerId, messageId int64) error {
+	data, err := json.Marshal(dto.MarkReadReq{
+		MessageId: messageId,
+	})
+	if err != nil {
+		return err
+	}
+	event := ws.Event{
+		Type: ws.EventRead,
+		From: readerId,
+		To:   partnerId,
+		Data: data,
+	}
+	for _, userId := range []int64{partnerId, readerId} {
+		err = hub.Publish(ctx, userId, event)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newErrorEvent(mes string) ws.Event {
+	data, _ := json.Marshal(dto.ErrorRes{
+		Error: mes,
+	})
+	return ws.Event{
+		Type: ws.EventError,
+		Data: data,
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"flame/internal/services/api/dto"
+	"flame/pkg/res"
+	"net/http"
+)
+
+// HasRole lets the request through only when the role from the access token is one of roles.
+// It must be used after IsAuthed.
+func HasRole(roles ...string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authData, ok := r.Context().Value("authData").(AuthData)
+			if !ok {
+				writeUnauthed(w)
+				return
+			}
+			for _, role := range roles {
+				if authData.Role == role {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			res.Json(w, dto.ErrorRes{
+				Error: http.StatusText(http.StatusForbidden),
+			}, http.StatusForbidden)
+		})
+	}
+}
@@ -2,15 +2,24 @@ package middleware
 
 import (
 	"context"
+	"flame/internal/models"
 	"flame/internal/services/api/dto"
+	"flame/pkg/db"
+	http_errors "flame/pkg/errors"
 	"flame/pkg/jwt"
+	"flame/pkg/pb"
 	"flame/pkg/res"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
 	"strings"
 )
 
 type AuthData struct {
-	Id int64
+	Id   int64
+	Role string
 }
 
 func writeUnauthed(w http.ResponseWriter) {
@@ -19,7 +28,38 @@ func writeUnauthed(w http.ResponseWriter) {
 	}, http.StatusUnauthorized)
 }
 
-func IsAuthed(secret string) func(next http.Handler) http.Handler {
+// StatusClient is the part of the account service that IsAuthed asks for statuses that are not
+// cached.
+type StatusClient interface {
+	GetStatus(ctx context.Context, in *pb.GetStatusReq, opts ...grpc.CallOption) (*pb.GetStatusRes, error)
+}
+
+// Statuses reads account statuses from the cache written by the account service and falls back
+// to the service itself, which seeds the cache again, when the key is missing.
+type Statuses struct {
+	Redis    *db.Redis
+	Accounts StatusClient
+}
+
+func (statuses *Statuses) Get(ctx context.Context, userId int64) (string, error) {
+	userStatus, err := statuses.Redis.Get(ctx, fmt.Sprintf("user:%d:status", userId)).Result()
+	if err == nil {
+		return userStatus, nil
+	}
+	// A missing key does not mean the user is active, it may have been evicted or flushed.
+	response, err := statuses.Accounts.GetStatus(ctx, &pb.GetStatusReq{
+		UserId: userId,
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.Status, nil
+}
+
+// IsAuthed checks the access token and rejects users whose account is banned or suspended.
+// The status is checked on every request, so it applies to tokens issued before the sanction as
+// well. If it cannot be checked the request is rejected.
+func IsAuthed(secret string, statuses *Statuses) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authedHeader := r.Header.Get("Authorization")
@@ -34,8 +74,32 @@ func IsAuthed(secret string) func(next http.Handler) http.Handler {
 				writeUnauthed(w)
 				return
 			}
+			if statuses != nil {
+				userStatus, err := statuses.Get(r.Context(), data.Id)
+				if status.Code(err) == codes.NotFound {
+					writeUnauthed(w)
+					return
+				}
+				if err != nil {
+					res.Json(w, dto.ErrorRes{
+						Error: http.StatusText(http.StatusServiceUnavailable),
+					}, http.StatusServiceUnavailable)
+					return
+				}
+				if userStatus != string(models.StatusActive) {
+					mes := http_errors.AccountSuspended
+					if userStatus == string(models.StatusBanned) {
+						mes = http_errors.AccountBanned
+					}
+					res.Json(w, dto.ErrorRes{
+						Error: mes,
+					}, http.StatusForbidden)
+					return
+				}
+			}
 			ctx := context.WithValue(r.Context(), "authData", AuthData{
-				Id: data.Id,
+				Id:   data.Id,
+				Role: data.Role,
 			})
 			req := r.WithContext(ctx)
 			next.ServeHTTP(w, req)
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"flame/internal/models"
+	"flame/pkg/jwt"
+	"flame/pkg/pb"
+	"flame/tests/mocks"
+	"github.com/go-playground/assert/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type statusClient struct {
+	status string
+	err    error
+	calls  int
+}
+
+func (client *statusClient) GetStatus(ctx context.Context, in *pb.GetStatusReq, opts ...grpc.CallOption) (*pb.GetStatusRes, error) {
+	client.calls++
+	if client.err != nil {
+		return nil, client.err
+	}
+	return &pb.GetStatusRes{Status: client.status}, nil
+}
+
+func TestIsAuthed(t *testing.T) {
+	const secret = "secret"
+	server, redis := mocks.NewRedis(t)
+	token, err := jwt.NewJWT(secret).Create(jwt.Data{Id: 1}, time.Now().Add(time.Hour))
+	assert.Equal(t, nil, err)
+	tests := []struct {
+		name    string
+		header  string
+		cached  string
+		account *statusClient
+		code    int
+		calls   int
+	}{
+		{
+			name:    "cached active",
+			header:  "Bearer " + token,
+			cached:  string(models.StatusActive),
+			account: &statusClient{},
+			code:    http.StatusOK,
+		},
+		{
+			name:    "cached banned",
+			header:  "Bearer " + token,
+			cached:  string(models.StatusBanned),
+			account: &statusClient{},
+			code:    http.StatusForbidden,
+		},
+		{
+			name:    "cached suspended",
+			header:  "Bearer " + token,
+			cached:  string(models.StatusSuspended),
+			account: &statusClient{},
+			code:    http.StatusForbidden,
+		},
+		{
+			name:    "cache miss banned",
+			header:  "Bearer " + token,
+			account: &statusClient{status: string(models.StatusBanned)},
+			code:    http.StatusForbidden,
+			calls:   1,
+		},
+		{
+			name:    "cache miss active",
+			header:  "Bearer " + token,
+			account: &statusClient{status: string(models.StatusActive)},
+			code:    http.StatusOK,
+			calls:   1,
+		},
+		{
+			name:    "cache miss user does not exist",
+			header:  "Bearer " + token,
+			account: &statusClient{err: status.Errorf(codes.NotFound, "")},
+			code:    http.StatusUnauthorized,
+			calls:   1,
+		},
+		{
+			name:    "cache miss account service down",
+			header:  "Bearer " + token,
+			account: &statusClient{err: errors.New("")},
+			code:    http.StatusServiceUnavailable,
+			calls:   1,
+		},
+		{
+			name:    "no token",
+			account: &statusClient{},
+			code:    http.StatusUnauthorized,
+		},
+		{
+			name:    "invalid token",
+			header:  "Bearer " + token + "x",
+			account: &statusClient{},
+			code:    http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server.Flush()
+			if tt.cached != "" {
+				err := redis.Set(context.Background(), "user:1:status", tt.cached, 0).Err()
+				assert.Equal(t, nil, err)
+			}
+			var authData AuthData
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authData = r.Context().Value("authData").(AuthData)
+			})
+			handler := IsAuthed(secret, &Statuses{
+				Redis:    redis,
+				Accounts: tt.account,
+			})(next)
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, w.Code, tt.code)
+			assert.Equal(t, tt.account.calls, tt.calls)
+			if tt.code == http.StatusOK {
+				assert.Equal(t, authData.Id, int64(1))
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package middleware
+
+import "net/http"
+
+// QueryToken moves the access_token query parameter into the Authorization
+// header, since browsers cannot set headers on a WebSocket handshake.
+func QueryToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("access_token")
+		if r.Header.Get("Authorization") == "" && token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,104 @@
+package ws
+
+import (
+	"encoding/json"
+	"github.com/gorilla/websocket"
+	"log/slog"
+	"time"
+)
+
+const (
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
+	pingPeriod     = pongWait * 9 / 10
+	maxMessageSize = 8 << 10
+	sendBufferSize = 64
+)
+
+// Client is a single device connection of a user.
+type Client struct {
+	UserId int64
+	hub    *Hub
+	conn   *websocket.Conn
+	send   chan []byte
+}
+
+func NewClient(hub *Hub, conn *websocket.Conn, userId int64) *Client {
+	client := &Client{
+		UserId: userId,
+		hub:    hub,
+		conn:   conn,
+		send:   make(chan []byte, sendBufferSize),
+	}
+	hub.register(client)
+	return client
+}
+
+// ReadPump passes every incoming event to handle until the connection
+// closes, then unregisters the client.
+func (client *Client) ReadPump(handle func(event IncomingEvent)) {
+	defer func() {
+		client.hub.unregister(client)
+		client.conn.Close()
+	}()
+	client.conn.SetReadLimit(maxMessageSize)
+	client.conn.SetReadDeadline(time.Now().Add(pongWait))
+	client.conn.SetPongHandler(func(string) error {
+		return client.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	for {
+		var event IncomingEvent
+		err := client.conn.ReadJSON(&event)
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				client.hub.Logger.Error(err.Error(),
+					slog.String("Error location", "Client.ReadPump"),
+					slog.Int64("UserId", client.UserId),
+				)
+			}
+			return
+		}
+		handle(event)
+	}
+}
+
+func (client *Client) WritePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		client.conn.Close()
+	}()
+	for {
+		select {
+		case payload, ok := <-client.send:
+			client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				client.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			err := client.conn.WriteMessage(websocket.TextMessage, payload)
+			if err != nil {
+				return
+			}
+		case <-ticker.C:
+			client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			err := client.conn.WriteMessage(websocket.PingMessage, nil)
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Reply sends an event to this connection only. It must be called from
+// the handler passed to ReadPump.
+func (client *Client) Reply(event Event) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	select {
+	case client.send <- data:
+	default:
+	}
+}
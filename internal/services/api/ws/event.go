@@ -0,0 +1,24 @@
+package ws
+
+import "encoding/json"
+
+const (
+	EventMessage = "message"
+	EventTyping  = "typing"
+	EventRead    = "read"
+	EventError   = "error"
+)
+
+type Event struct {
+	Type string          `json:"type"`
+	From int64           `json:"from,omitempty"`
+	To   int64           `json:"to,omitempty"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+type IncomingEvent struct {
+	Type      string `json:"type"`
+	To        int64  `json:"to"`
+	Text      string `json:"text,omitempty"`
+	MessageId int64  `json:"message_id,omitempty"`
+}
@@ -0,0 +1,109 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"flame/pkg/db"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	channelPrefix  = "ws:user:"
+	channelPattern = channelPrefix + "*"
+)
+
+type HubDeps struct {
+	Redis  *db.Redis
+	Logger *slog.Logger
+}
+
+// Hub keeps the WebSocket connections of this gateway replica and relays
+// events between replicas through Redis pub/sub, one channel per user.
+type Hub struct {
+	Redis   *db.Redis
+	Logger  *slog.Logger
+	mu      sync.RWMutex
+	clients map[int64]map[*Client]struct{}
+}
+
+func NewHub(deps *HubDeps) *Hub {
+	return &Hub{
+		Redis:   deps.Redis,
+		Logger:  deps.Logger,
+		clients: make(map[int64]map[*Client]struct{}),
+	}
+}
+
+func (hub *Hub) Run(ctx context.Context) {
+	pubsub := hub.Redis.PSubscribe(ctx, channelPattern)
+	defer pubsub.Close()
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			userId, err := strconv.ParseInt(strings.TrimPrefix(msg.Channel, channelPrefix), 10, 64)
+			if err != nil {
+				hub.Logger.Error(err.Error(),
+					slog.String("Error location", "Hub.Run.strconv.ParseInt"),
+					slog.String("Channel", msg.Channel),
+				)
+				continue
+			}
+			hub.deliver(userId, []byte(msg.Payload))
+		}
+	}
+}
+
+func (hub *Hub) Publish(ctx context.Context, userId int64, event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return hub.Redis.Publish(ctx, fmt.Sprintf("%s%d", channelPrefix, userId), data).Err()
+}
+
+func (hub *Hub) register(client *Client) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	if hub.clients[client.UserId] == nil {
+		hub.clients[client.UserId] = make(map[*Client]struct{})
+	}
+	hub.clients[client.UserId][client] = struct{}{}
+}
+
+func (hub *Hub) unregister(client *Client) {
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	clients := hub.clients[client.UserId]
+	if _, ok := clients[client]; !ok {
+		return
+	}
+	delete(clients, client)
+	close(client.send)
+	if len(clients) == 0 {
+		delete(hub.clients, client.UserId)
+	}
+}
+
+func (hub *Hub) deliver(userId int64, payload []byte) {
+	hub.mu.RLock()
+	defer hub.mu.RUnlock()
+	for client := range hub.clients[userId] {
+		select {
+		case client.send <- payload:
+		default:
+			hub.Logger.Warn("client send buffer is full",
+				slog.Int64("UserId", userId),
+			)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package ws
+
+import (
+	"github.com/go-playground/assert/v2"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckOrigin(t *testing.T) {
+	check := CheckOrigin([]string{"https://flame.app", "http://localhost:3000/"})
+	tests := []struct {
+		name   string
+		origin string
+		res    bool
+	}{
+		{name: "no origin", origin: "", res: true},
+		{name: "allowed", origin: "https://flame.app", res: true},
+		{name: "allowed with trailing slash in config", origin: "http://localhost:3000", res: true},
+		{name: "same host", origin: "https://api.flame.app", res: true},
+		{name: "other site", origin: "https://evil.example", res: false},
+		{name: "other port", origin: "https://flame.app:8443", res: false},
+		{name: "malformed", origin: "%zz", res: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "https://api.flame.app/ws/", nil)
+			if test.origin != "" {
+				r.Header.Set("Origin", test.origin)
+			}
+			assert.Equal(t, test.res, check(r))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package chat
+
+import (
+	"flame/internal/config"
+	"flame/pkg/db"
+	"flame/pkg/pb"
+	"google.golang.org/grpc"
+	"log/slog"
+	"net"
+)
+
+type AppDeps struct {
+	Config *config.Config
+	Logger *slog.Logger
+	DB     *db.DB
+	Mode   string
+}
+type App struct {
+	Config *config.Config
+	Logger *slog.Logger
+	DB     *db.DB
+	Mode   string
+}
+
+func NewApp(deps *AppDeps) *App {
+	return &App{
+		Config: deps.Config,
+		Logger: deps.Logger,
+		DB:     deps.DB,
+		Mode:   deps.Mode,
+	}
+}
+
+func (app *App) Run() error {
+	var opts []grpc.ServerOption
+	lis, err := net.Listen("tcp", app.Config.Services.Chat.Address)
+	if err != nil {
+		app.Logger.Error(err.Error(),
+			slog.String("Error location", "net.Listen"),
+			slog.String("Chat address", app.Config.Services.Chat.Address),
+		)
+		return err
+	}
+	defer lis.Close()
+
+	repository := NewRepository(&RepositoryDeps{
+		DB: app.DB,
+	})
+	service := NewService(&ServiceDeps{
+		Repository: repository,
+		Logger:     app.Logger,
+	})
+	handler := NewHandler(&HandlerDeps{
+		Logger:  app.Logger,
+		Config:  app.Config,
+		Service: service,
+	})
+	server := grpc.NewServer(opts...)
+	defer server.Stop()
+	pb.RegisterChatServer(server, handler)
+	app.Logger.Info("Service starts",
+		slog.String("Name", "Chat"),
+		slog.String("Address", app.Config.Services.Chat.Address),
+		slog.String("Mode", app.Mode),
+	)
+	err = server.Serve(lis)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package chat
+
+import (
+	"context"
+	"flame/internal/config"
+	"flame/internal/interfaces"
+	"flame/internal/mappers"
+	http_errors "flame/pkg/errors"
+	grpc_conn "flame/pkg/grpc-conn"
+	"flame/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+)
+
+type Handler struct {
+	Logger       *slog.Logger
+	Config       *config.Config
+	Service      interfaces.ChatService
+	SwipesClient pb.SwipesClient
+	pb.UnsafeChatServer
+}
+
+type HandlerDeps struct {
+	Logger  *slog.Logger
+	Config  *config.Config
+	Service interfaces.ChatService
+}
+
+func NewHandler(deps *HandlerDeps) *Handler {
+	swipesConn, err := grpc_conn.NewClientConn(deps.Config.Services.Swipes.Address)
+	if err != nil {
+		deps.Logger.Error(err.Error(),
+			slog.String("Error location", "NewChatHandler.grpc_conn.NewClientConn"),
+			slog.String("Swipes address", deps.Config.Services.Swipes.Address),
+		)
+		return nil
+	}
+	swipesClient := pb.NewSwipesClient(swipesConn)
+
+	return &Handler{
+		Logger:       deps.Logger,
+		Config:       deps.Config,
+		Service:      deps.Service,
+		SwipesClient: swipesClient,
+	}
+}
+
+func (handler *Handler) SendMessage(ctx context.Context, r *pb.SendMessageReq) (*pb.SendMessageRes, error) {
+	if r.SenderId == r.RecipientId {
+		return nil, status.Errorf(codes.InvalidArgument, http.StatusText(http.StatusBadRequest))
+	}
+	matched, err := handler.SwipesClient.IsMatched(ctx, &pb.IsMatchedReq{
+		UserId1: r.SenderId,
+		UserId2: r.RecipientId,
+	})
+	if err != nil {
+		handler.Logger.Error(err.Error(),
+			slog.String("Error location", "handler.SwipesClient.IsMatched"),
+			slog.Int64("SenderId", r.SenderId),
+			slog.Int64("RecipientId", r.RecipientId),
+		)
+		return nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	if !matched.IsMatched {
+		return nil, status.Errorf(codes.PermissionDenied, http_errors.NotMatched)
+	}
+	message, err := handler.Service.SendMessage(r.SenderId, r.RecipientId, r.Text)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SendMessageRes{
+		Message: mappers.FromModelMessageToGrpc(*message),
+	}, nil
+}
+
+func (handler *Handler) GetMessages(ctx context.Context, r *pb.GetMessagesReq) (*pb.GetMessagesRes, error) {
+	messages, nextCursor, err := handler.Service.GetMessages(r.UserId, r.PartnerId, r.Cursor, r.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMessagesRes{
+		Messages:   mappers.FromModelMessagesToGrpc(messages),
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (handler *Handler) MarkRead(ctx context.Context, r *pb.MarkReadReq) (*pb.MarkReadRes, error) {
+	count, err := handler.Service.MarkRead(r.UserId, r.PartnerId, r.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MarkReadRes{
+		Count: count,
+	}, nil
+}
+
+func (handler *Handler) GetConversations(ctx context.Context, r *pb.GetConversationsReq) (*pb.GetConversationsRes, error) {
+	conversations := handler.Service.GetConversations(r.UserId)
+	return &pb.GetConversationsRes{
+		Conversations: mappers.FromModelConversationsToGrpc(conversations, r.UserId),
+	}, nil
+}
+
+func (handler *Handler) DeleteConversation(ctx context.Context, r *pb.DeleteConversationReq) (*pb.DeleteConversationRes, error) {
+	err := handler.Service.DeleteConversation(r.UserId1, r.UserId2)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteConversationRes{}, nil
+}
@@ -0,0 +1,114 @@
+package chat
+
+import (
+	"flame/internal/models"
+	"flame/pkg/db"
+)
+
+type RepositoryDeps struct {
+	DB *db.DB
+}
+type Repository struct {
+	DB *db.DB
+}
+
+func NewRepository(deps *RepositoryDeps) *Repository {
+	return &Repository{
+		DB: deps.DB,
+	}
+}
+
+func (repo *Repository) GetOrCreateConversation(userId1, userId2 int64) (*models.Conversation, error) {
+	var conversation models.Conversation
+	if userId1 > userId2 {
+		id1 := userId1
+		userId1 = userId2
+		userId2 = id1
+	}
+	err := repo.DB.Get(&conversation, `INSERT INTO conversations (user_id1, user_id2) VALUES ($1,$2)
+		ON CONFLICT (user_id1, user_id2) DO UPDATE SET updated_at=now()
+		RETURNING id, user_id1, user_id2, created_at, updated_at`, userId1, userId2)
+	if err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+func (repo *Repository) GetConversation(userId1, userId2 int64) *models.Conversation {
+	var conversation models.Conversation
+	if userId1 > userId2 {
+		id1 := userId1
+		userId1 = userId2
+		userId2 = id1
+	}
+	err := repo.DB.Get(&conversation, `SELECT id, user_id1, user_id2, created_at, updated_at FROM conversations 
+		WHERE user_id1=$1 AND user_id2=$2`, userId1, userId2)
+	if err != nil {
+		return nil
+	}
+	return &conversation
+}
+
+func (repo *Repository) GetConversations(userId int64) []models.Conversation {
+	var conversations []models.Conversation
+	err := repo.DB.Select(&conversations, `SELECT c.id, c.user_id1, c.user_id2, c.created_at, c.updated_at,
+       			(SELECT count(*) FROM messages m WHERE m.conversation_id = c.id AND m.sender_id != $1 AND m.read_at IS NULL) as unread
+				FROM conversations c
+				WHERE c.user_id1=$1 OR c.user_id2=$1
+				ORDER BY c.updated_at DESC`, userId)
+	if err != nil {
+		return nil
+	}
+	return conversations
+}
+
+func (repo *Repository) CreateMessage(message *models.Message) (*models.Message, error) {
+	var created models.Message
+	err := repo.DB.Get(&created, `INSERT INTO messages (conversation_id, sender_id, text) VALUES ($1,$2,$3)
+		RETURNING id, conversation_id, sender_id, text, created_at, read_at`,
+		message.ConversationId, message.SenderId, message.Text)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (repo *Repository) GetMessages(conversationId int64, cursor *int64, limit int32) ([]models.Message, error) {
+	var messages []models.Message
+	var err error
+	if cursor == nil {
+		err = repo.DB.Select(&messages, `SELECT id, conversation_id, sender_id, text, created_at, read_at FROM messages
+			WHERE conversation_id=$1
+			ORDER BY id DESC
+			LIMIT $2`, conversationId, limit)
+	} else {
+		err = repo.DB.Select(&messages, `SELECT id, conversation_id, sender_id, text, created_at, read_at FROM messages
+			WHERE conversation_id=$1 AND id < $2
+			ORDER BY id DESC
+			LIMIT $3`, conversationId, *cursor, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func (repo *Repository) MarkRead(conversationId, readerId, messageId int64) (int64, error) {
+	result, err := repo.DB.Exec(`UPDATE messages SET read_at=now() 
+		WHERE conversation_id=$1 AND sender_id != $2 AND id <= $3 AND read_at IS NULL`,
+		conversationId, readerId, messageId)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (repo *Repository) DeleteConversation(userId1, userId2 int64) error {
+	if userId1 > userId2 {
+		id1 := userId1
+		userId1 = userId2
+		userId2 = id1
+	}
+	_, err := repo.DB.Exec(`DELETE FROM conversations WHERE user_id1=$1 AND user_id2=$2`, userId1, userId2)
+	return err
+}
@@ -0,0 +1,123 @@
+package chat
+
+import (
+	"flame/internal/interfaces"
+	"flame/internal/models"
+	http_errors "flame/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	defaultMessagesLimit = 50
+	maxMessagesLimit     = 100
+	maxMessageLength     = 2000
+)
+
+type ServiceDeps struct {
+	Repository interfaces.ChatRepository
+	Logger     *slog.Logger
+}
+type Service struct {
+	Logger     *slog.Logger
+	Repository interfaces.ChatRepository
+}
+
+func NewService(deps *ServiceDeps) *Service {
+	return &Service{
+		Logger:     deps.Logger,
+		Repository: deps.Repository,
+	}
+}
+
+func (service *Service) SendMessage(senderId, recipientId int64, text string) (*models.Message, error) {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 || utf8.RuneCountInString(text) > maxMessageLength {
+		return nil, status.Errorf(codes.InvalidArgument, http_errors.InvalidMessage)
+	}
+	conversation, err := service.Repository.GetOrCreateConversation(senderId, recipientId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetOrCreateConversation"),
+			slog.Int64("SenderId", senderId),
+			slog.Int64("RecipientId", recipientId),
+		)
+		return nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	message, err := service.Repository.CreateMessage(&models.Message{
+		ConversationId: conversation.Id,
+		SenderId:       senderId,
+		Text:           text,
+	})
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.CreateMessage"),
+			slog.Int64("ConversationId", conversation.Id),
+		)
+		return nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	return message, nil
+}
+
+func (service *Service) GetMessages(userId, partnerId int64, cursor *int64, limit int32) ([]models.Message, *int64, error) {
+	if limit <= 0 {
+		limit = defaultMessagesLimit
+	}
+	if limit > maxMessagesLimit {
+		limit = maxMessagesLimit
+	}
+	conversation := service.Repository.GetConversation(userId, partnerId)
+	if conversation == nil {
+		return nil, nil, nil
+	}
+	messages, err := service.Repository.GetMessages(conversation.Id, cursor, limit)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetMessages"),
+			slog.Int64("ConversationId", conversation.Id),
+		)
+		return nil, nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	if len(messages) < int(limit) {
+		return messages, nil, nil
+	}
+	nextCursor := messages[len(messages)-1].Id
+	return messages, &nextCursor, nil
+}
+
+func (service *Service) MarkRead(userId, partnerId, messageId int64) (int64, error) {
+	conversation := service.Repository.GetConversation(userId, partnerId)
+	if conversation == nil {
+		return 0, status.Errorf(codes.NotFound, http_errors.ConversationNotFound)
+	}
+	count, err := service.Repository.MarkRead(conversation.Id, userId, messageId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.MarkRead"),
+			slog.Int64("ConversationId", conversation.Id),
+		)
+		return 0, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	return count, nil
+}
+
+func (service *Service) GetConversations(userId int64) []models.Conversation {
+	return service.Repository.GetConversations(userId)
+}
+
+func (service *Service) DeleteConversation(userId1, userId2 int64) error {
+	err := service.Repository.DeleteConversation(userId1, userId2)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.DeleteConversation"),
+			slog.Int64("UserId1", userId1),
+			slog.Int64("UserId2", userId2),
+		)
+		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	return nil
+}
@@ -1,14 +1,20 @@
 package mathcing
 
 import (
+	"context"
 	"flame/internal/config"
 	"flame/pkg/db"
+	"flame/pkg/events"
 	"flame/pkg/pb"
+	"fmt"
 	"google.golang.org/grpc"
 	"log/slog"
 	"net"
+	"os"
 )
 
+const eventsGroup = "matching"
+
 type AppDeps struct {
 	Config    *config.Config
 	Logger    *slog.Logger
@@ -57,12 +63,35 @@ func (app *App) Run() error {
 		Repository: repository,
 		Logger:     app.Logger,
 		Redis:      app.Redis,
+		Config:     app.Config,
 	})
 	handler := NewHandler(&HandlerDeps{
 		Logger:  app.Logger,
 		Config:  app.Config,
 		Service: service,
 	})
+	consumer := events.NewConsumer(&events.ConsumerDeps{
+		Redis:          app.Redis,
+		Logger:         app.Logger,
+		Stream:         events.AccountStream,
+		Group:          eventsGroup,
+		Name:           consumerName(),
+		Handler:        service.HandleAccountEvent,
+		IdempotencyTTL: app.Config.Events.IdempotencyTTL,
+		MaxDeliveries:  app.Config.Events.MaxDeliveries,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		err := consumer.Run(ctx)
+		if err != nil {
+			app.Logger.Error(err.Error(),
+				slog.String("Error location", "consumer.Run"),
+				slog.String("Stream", events.AccountStream),
+			)
+		}
+	}()
+
 	server := grpc.NewServer(opts...)
 	defer server.Stop()
 	pb.RegisterMatchingServer(server, handler)
@@ -77,3 +106,11 @@ func (app *App) Run() error {
 	}
 	return nil
 }
+
+func consumerName() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "matching"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
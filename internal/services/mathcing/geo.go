@@ -0,0 +1,254 @@
+package mathcing
+
+import (
+	"context"
+	"errors"
+	"flame/internal/models"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"github.com/umahmood/haversine"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+)
+
+const (
+	// geoRegionsKey maps a user to the region key their location is indexed under, so the old
+	// entry can be removed when they move to another region.
+	geoRegionsKey = "geo:regions"
+	// geoReadyKey is set once the index was filled from PostGIS. Until then discovery does not
+	// use the index.
+	geoReadyKey = "geo:ready"
+
+	// regionSize is the side of a region in degrees.
+	regionSize  = 1.0
+	kmPerDegree = 111.32
+	// maxGeoLat is the highest latitude Redis can index.
+	maxGeoLat = 85.05112878
+	// geoRadiusSlack widens the search, Redis measures distances on a sphere and PostGIS on the
+	// spheroid. st_dwithin makes the final decision.
+	geoRadiusSlack = 1.01
+	// geoDriftKm is how far an indexed position may be from the stored one before the checker
+	// reindexes it.
+	geoDriftKm   = 0.01
+	geoBatchSize = 1000
+)
+
+func regionKey(latCell, lonCell int) string {
+	return fmt.Sprintf("geo:users:%d:%d", latCell, lonCell)
+}
+
+func regionOf(lon, lat float64) string {
+	return regionKey(int(math.Floor(lat/regionSize)), wrapLonCell(int(math.Floor(lon/regionSize))))
+}
+
+func wrapLonCell(cell int) int {
+	cells := int(360 / regionSize)
+	return ((cell+cells/2)%cells+cells)%cells - cells/2
+}
+
+// regionsWithin returns the regions a circle of radiusKm around lon/lat overlaps. It returns false
+// if the circle reaches latitudes Redis cannot index.
+func regionsWithin(lon, lat, radiusKm float64) ([]string, bool) {
+	dLat := radiusKm / kmPerDegree
+	if lat+dLat >= maxGeoLat || lat-dLat <= -maxGeoLat {
+		return nil, false
+	}
+	dLon := math.Min(radiusKm/(kmPerDegree*math.Cos(lat*math.Pi/180)), 180)
+	minLat, maxLat := int(math.Floor((lat-dLat)/regionSize)), int(math.Floor((lat+dLat)/regionSize))
+	minLon, maxLon := int(math.Floor((lon-dLon)/regionSize)), int(math.Floor((lon+dLon)/regionSize))
+	if maxLon-minLon >= int(360/regionSize) {
+		minLon, maxLon = 0, int(360/regionSize)-1
+	}
+	var regions []string
+	for latCell := minLat; latCell <= maxLat; latCell++ {
+		for lonCell := minLon; lonCell <= maxLon; lonCell++ {
+			regions = append(regions, regionKey(latCell, wrapLonCell(lonCell)))
+		}
+	}
+	return regions, true
+}
+
+// findCandidates pre-selects the users around userId with the GEO index and loads the matching
+// ones from PostGIS. If the index cannot answer the whole search is done by PostGIS.
+func (service *Service) findCandidates(ctx context.Context, userId int64, lonLat *models.LonLat) ([]models.GetMatchingUser, error) {
+	nearbyIds, ok := service.nearbyIds(ctx, userId, lonLat)
+	if !ok {
+		nearbyIds = nil
+	}
+	return service.Repository.GetMatchingUsers(userId, service.Strict, nearbyIds)
+}
+
+func (service *Service) nearbyIds(ctx context.Context, userId int64, lonLat *models.LonLat) ([]int64, bool) {
+	ready, err := service.Redis.Exists(ctx, geoReadyKey).Result()
+	if err != nil {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.Exists"))
+		return nil, false
+	}
+	if ready == 0 {
+		return nil, false
+	}
+	pref := service.Repository.GetPreferences(userId)
+	if pref == nil || pref.Distance == nil {
+		return nil, false
+	}
+	radius := float64(*pref.Distance) * geoRadiusSlack
+	regions, ok := regionsWithin(lonLat.Lon, lonLat.Lat, radius)
+	if !ok {
+		return nil, false
+	}
+	pipe := service.Redis.Pipeline()
+	results := make([]*redis.StringSliceCmd, len(regions))
+	for i, region := range regions {
+		results[i] = pipe.GeoSearch(ctx, region, &redis.GeoSearchQuery{
+			Longitude:  lonLat.Lon,
+			Latitude:   lonLat.Lat,
+			Radius:     radius,
+			RadiusUnit: "km",
+		})
+	}
+	_, err = pipe.Exec(ctx)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.GeoSearch"))
+		return nil, false
+	}
+	ids := make([]int64, 0)
+	for _, result := range results {
+		for _, member := range result.Val() {
+			id, err := strconv.ParseInt(member, 10, 64)
+			if err != nil || id == userId {
+				continue
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids, true
+}
+
+// indexLocation puts the user into the GEO index, or takes them out if candidate is nil.
+func (service *Service) indexLocation(ctx context.Context, userId int64, candidate *models.GetMatchingUser) error {
+	member := strconv.FormatInt(userId, 10)
+	old, err := service.Redis.HGet(ctx, geoRegionsKey, member).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	pipe := service.Redis.TxPipeline()
+	if candidate == nil || math.Abs(candidate.Lat) >= maxGeoLat {
+		if old == "" {
+			return nil
+		}
+		pipe.ZRem(ctx, old, member)
+		pipe.HDel(ctx, geoRegionsKey, member)
+		_, err = pipe.Exec(ctx)
+		return err
+	}
+	region := regionOf(candidate.Lon, candidate.Lat)
+	if old != "" && old != region {
+		pipe.ZRem(ctx, old, member)
+	}
+	pipe.GeoAdd(ctx, region, &redis.GeoLocation{
+		Name:      member,
+		Longitude: candidate.Lon,
+		Latitude:  candidate.Lat,
+	})
+	pipe.HSet(ctx, geoRegionsKey, member, region)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// CheckGeoIndex compares the GEO index with the locations stored in PostGIS and reindexes the
+// users that differ: missing, moved or no longer active. Differences are expected only if events
+// were lost or the index was evicted, they are logged. The first run fills the index and marks
+// it ready.
+func (service *Service) CheckGeoIndex(ctx context.Context) error {
+	started := time.Now()
+	users, err := service.Repository.GetLocatedUsers()
+	if err != nil {
+		return err
+	}
+	indexed, err := service.Redis.HGetAll(ctx, geoRegionsKey).Result()
+	if err != nil {
+		return err
+	}
+	var missing, moved, stale []int64
+	for start := 0; start < len(users); start += geoBatchSize {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		batch := users[start:min(start+geoBatchSize, len(users))]
+		pipe := service.Redis.Pipeline()
+		positions := make([]*redis.GeoPosCmd, len(batch))
+		for i, user := range batch {
+			member := strconv.FormatInt(user.Id, 10)
+			if region, ok := indexed[member]; ok {
+				positions[i] = pipe.GeoPos(ctx, region, member)
+			}
+		}
+		_, err = pipe.Exec(ctx)
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+		for i, user := range batch {
+			member := strconv.FormatInt(user.Id, 10)
+			region, ok := indexed[member]
+			delete(indexed, member)
+			if math.Abs(user.Lat) >= maxGeoLat {
+				if ok {
+					stale = append(stale, user.Id)
+				}
+				continue
+			}
+			if !ok {
+				missing = append(missing, user.Id)
+				continue
+			}
+			pos := positions[i].Val()
+			if region != regionOf(user.Lon, user.Lat) || len(pos) == 0 || pos[0] == nil ||
+				driftKm(pos[0], user) > geoDriftKm {
+				moved = append(moved, user.Id)
+			}
+		}
+	}
+	for member := range indexed {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			service.Redis.HDel(ctx, geoRegionsKey, member)
+			continue
+		}
+		stale = append(stale, id)
+	}
+
+	// The location may have changed since it was read, so every user is reindexed from its
+	// current state.
+	for _, ids := range [][]int64{missing, moved, stale} {
+		for _, id := range ids {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			err = service.indexLocation(ctx, id, service.Repository.GetCandidate(id))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = service.Redis.Set(ctx, geoReadyKey, started.Unix(), 0).Err()
+	if err != nil {
+		return err
+	}
+	service.Logger.Info("GEO index checked",
+		slog.Int("Users", len(users)),
+		slog.Int("Missing", len(missing)),
+		slog.Int("Moved", len(moved)),
+		slog.Int("Stale", len(stale)),
+	)
+	return nil
+}
+
+func driftKm(pos *redis.GeoPos, user models.UserLocation) float64 {
+	_, km := haversine.Distance(
+		haversine.Coord{Lat: pos.Latitude, Lon: pos.Longitude},
+		haversine.Coord{Lat: user.Lat, Lon: user.Lon},
+	)
+	return km
+}
@@ -0,0 +1,57 @@
+package mathcing
+
+import (
+	"github.com/go-playground/assert/v2"
+	"testing"
+)
+
+func TestRegionsWithin(t *testing.T) {
+	tests := []struct {
+		name     string
+		lon, lat float64
+		radius   float64
+		regions  []string
+		ok       bool
+	}{
+		{
+			name:    "inside one region",
+			lon:     37.5,
+			lat:     55.5,
+			radius:  10,
+			regions: []string{"geo:users:55:37"},
+			ok:      true,
+		},
+		{
+			name:    "crosses region borders",
+			lon:     37.95,
+			lat:     55.95,
+			radius:  20,
+			regions: []string{"geo:users:55:37", "geo:users:55:38", "geo:users:56:37", "geo:users:56:38"},
+			ok:      true,
+		},
+		{
+			name:    "crosses the antimeridian",
+			lon:     179.9,
+			lat:     0.5,
+			radius:  20,
+			regions: []string{"geo:users:0:179", "geo:users:0:-180"},
+			ok:      true,
+		},
+		{
+			name:   "beyond indexable latitudes",
+			lon:    0,
+			lat:    85,
+			radius: 50,
+			ok:     false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			regions, ok := regionsWithin(test.lon, test.lat, test.radius)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.regions, regions)
+		})
+	}
+	assert.Equal(t, "geo:users:0:-180", regionOf(180, 0.5))
+	assert.Equal(t, "geo:users:-1:-1", regionOf(-0.5, -0.5))
+}
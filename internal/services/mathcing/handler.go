@@ -32,15 +32,27 @@ func NewHandler(deps *HandlerDeps) *Handler {
 }
 
 func (handler *Handler) GetMatchingUsers(ctx context.Context, r *pb.GetMatchingUsersReq) (*pb.GetMatchingUsersRes, error) {
-	users, lonLat, err := handler.Service.GetMatchingUsers(r.Id, r.Location)
+	users, lonLat, nextPageToken, err := handler.Service.GetMatchingUsers(r.Id, r.Location, r.PageSize, r.PageToken)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.GetMatchingUsersRes{
-		Users: mappers.FromModelGetMatchingUsersToGrpc(users, lonLat),
+		Users:         mappers.FromModelGetMatchingUsersToGrpc(users, r.Id, lonLat),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 func (handler *Handler) UpdateRedis(ctx context.Context, r *pb.UpdateRedisReq) (*emptypb.Empty, error) {
 	err := handler.Service.UpdateRedis(r.UserId)
 	return &emptypb.Empty{}, err
 }
+
+func (handler *Handler) GetLikes(ctx context.Context, r *pb.GetLikesReq) (*pb.GetLikesRes, error) {
+	likes, lonLat, nextPageToken, err := handler.Service.GetLikes(r.UserId, r.PageSize, r.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLikesRes{
+		Likes:         mappers.FromModelLikeCardsToGrpc(likes, r.UserId, lonLat),
+		NextPageToken: nextPageToken,
+	}, nil
+}
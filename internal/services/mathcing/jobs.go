@@ -0,0 +1,130 @@
+package mathcing
+
+import (
+	"context"
+	"errors"
+	"flame/pkg/scheduler"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// defaultPoolMaxAge is used when the config leaves it at zero, which would rebuild every pool on
+// each run.
+const defaultPoolMaxAge = 6 * time.Hour
+
+type JobsDeps struct {
+	Service    *Service
+	PoolMaxAge time.Duration
+}
+
+func NewJobs(deps *JobsDeps) []scheduler.Job {
+	poolMaxAge := deps.PoolMaxAge
+	if poolMaxAge <= 0 {
+		poolMaxAge = defaultPoolMaxAge
+	}
+	return []scheduler.Job{
+		{
+			Name:     "matching.rebuild_stale_pools",
+			Schedule: scheduler.Every(15 * time.Minute),
+			Timeout:  10 * time.Minute,
+			Retries:  2,
+			Run: func(ctx context.Context) error {
+				return deps.Service.RebuildStalePools(ctx, poolMaxAge)
+			},
+		},
+		{
+			Name:     "matching.check_geo_index",
+			Schedule: scheduler.Every(30 * time.Minute),
+			Timeout:  10 * time.Minute,
+			Retries:  2,
+			Run:      deps.Service.CheckGeoIndex,
+		},
+	}
+}
+
+// travelCheckedKey holds the unix time up to which trips that started or ended were applied.
+const travelCheckedKey = "pools:travel_checked"
+
+// RebuildStalePools rebuilds the cached candidate pools built more than maxAge ago, so that
+// scores depending on time, like activity, do not drift. Pools that were dropped since are
+// forgotten, they are built again on the next request.
+func (service *Service) RebuildStalePools(ctx context.Context, maxAge time.Duration) error {
+	err := service.applyTravelChanges(ctx, maxAge)
+	if err != nil {
+		return err
+	}
+	userIds, err := service.Redis.ZRangeByScore(ctx, builtPoolsKey, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(time.Now().Add(-maxAge).Unix(), 10),
+	}).Result()
+	if err != nil {
+		return err
+	}
+	for _, member := range userIds {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		userId, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			service.Redis.ZRem(ctx, builtPoolsKey, member)
+			continue
+		}
+		exists, err := service.Redis.Exists(ctx, fmt.Sprintf("user:%d:candidates", userId)).Result()
+		if err != nil {
+			return err
+		}
+		if exists == 0 || service.Repository.GetLonLat(userId) == nil {
+			service.Redis.ZRem(ctx, builtPoolsKey, member)
+			continue
+		}
+		err = service.UpdateRedis(userId)
+		if err != nil {
+			service.Logger.Error(err.Error(),
+				slog.String("Error location", "service.UpdateRedis"),
+				slog.Int64("UserId", userId),
+			)
+		}
+	}
+	return nil
+}
+
+// applyTravelChanges moves the users whose trip started or ended since the last run to their new
+// discovery location. Setting or ending a trip is applied right away, but its start and end times
+// pass without any request. The first run looks maxAge back, older changes are already in rebuilt
+// pools.
+func (service *Service) applyTravelChanges(ctx context.Context, maxAge time.Duration) error {
+	until := time.Now()
+	since := until.Add(-maxAge)
+	checked, err := service.Redis.Get(ctx, travelCheckedKey).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+	if err == nil && checked > since.Unix() {
+		since = time.Unix(checked, 0)
+	}
+	userIds, err := service.Repository.GetTravelChangedIds(since, until)
+	if err != nil {
+		return err
+	}
+	for _, userId := range userIds {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// The pool of the traveller is dropped and built from the new location on the next request.
+		pipe := service.Redis.TxPipeline()
+		pipe.Del(ctx, fmt.Sprintf("user:%d:candidates", userId))
+		pipe.ZRem(ctx, builtPoolsKey, userId)
+		_, err = pipe.Exec(ctx)
+		if err != nil {
+			return err
+		}
+		err = service.syncPools(ctx, userId)
+		if err != nil {
+			return err
+		}
+	}
+	return service.Redis.Set(ctx, travelCheckedKey, until.Unix(), 0).Err()
+}
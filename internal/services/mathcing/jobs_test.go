@@ -0,0 +1,103 @@
+package mathcing
+
+import (
+	"context"
+	"flame/internal/config"
+	"flame/internal/models"
+	"flame/tests/mocks"
+	"github.com/go-playground/assert/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/mock"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestService_RebuildStalePools_Travel(t *testing.T) {
+	const maxAge = 6 * time.Hour
+	lastRun := time.Now().Add(-15 * time.Minute).Unix()
+	tests := []struct {
+		name    string
+		checked string
+		// since is how far back the trips are looked up.
+		since     time.Duration
+		travelled []int64
+		pools     map[int64][]string
+		built     []string
+	}{
+		{
+			name:      "trip started since the last run",
+			checked:   strconv.FormatInt(lastRun, 10),
+			since:     time.Since(time.Unix(lastRun, 0)),
+			travelled: []int64{2},
+			pools:     map[int64][]string{1: {"5"}, 2: nil},
+			built:     []string{"1"},
+		},
+		{
+			name:      "first run",
+			since:     maxAge,
+			travelled: []int64{2},
+			pools:     map[int64][]string{1: {"5"}, 2: nil},
+			built:     []string{"1"},
+		},
+		{
+			name:      "last run too long ago",
+			checked:   strconv.FormatInt(time.Now().Add(-2*maxAge).Unix(), 10),
+			since:     maxAge,
+			travelled: nil,
+			pools:     map[int64][]string{1: {"2", "5"}, 2: {"7"}},
+			built:     []string{"1", "2"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			server, rdb := mocks.NewRedis(t)
+			repo := new(mocks.MockMatchingRepository)
+			service := &Service{
+				Repository: repo,
+				Redis:      rdb,
+				Pipeline:   NewPipeline(config.ScoringWeights{}, 0),
+				Strict:     true,
+			}
+			if test.checked != "" {
+				rdb.Set(ctx, travelCheckedKey, test.checked, 0)
+			}
+			// Both pools are fresh, only the trip of user 2 makes them change.
+			rdb.ZAdd(ctx, "user:1:candidates", candidateZ(2), candidateZ(5))
+			rdb.ZAdd(ctx, "user:2:candidates", candidateZ(7))
+			rdb.ZAdd(ctx, builtPoolsKey, builtZ(1), builtZ(2))
+			rdb.SAdd(ctx, poolsKey(2), 1)
+
+			repo.On("GetTravelChangedIds", mock.MatchedBy(func(since time.Time) bool {
+				return math.Abs(time.Since(since).Seconds()-test.since.Seconds()) < 5
+			}), mock.Anything).Return(test.travelled, nil)
+			// User 2 is now matched from the other side of the world.
+			repo.On("GetCandidate", int64(2)).Return(&models.GetMatchingUser{
+				User: models.User{Id: 2, Name: "Anna"},
+				Lon:  2.35,
+				Lat:  48.86,
+			})
+			repo.On("GetPoolViewers", int64(2), true).Return([]models.PoolViewer{}, nil)
+			repo.On("GetSwipedByIds", int64(2)).Return([]int64{}, nil)
+			repo.On("GetLikedIds", int64(2)).Return([]int64{}, nil)
+
+			err := service.RebuildStalePools(ctx, maxAge)
+			assert.Equal(t, nil, err)
+
+			repo.AssertNumberOfCalls(t, "GetTravelChangedIds", 1)
+			repo.AssertNumberOfCalls(t, "GetCandidate", len(test.travelled))
+			assert.Equal(t, test.pools[1], server.Members("user:1:candidates"))
+			assert.Equal(t, test.pools[2], server.Members("user:2:candidates"))
+			assert.Equal(t, test.built, server.Members(builtPoolsKey))
+			checked, ok := server.Get(travelCheckedKey)
+			assert.Equal(t, true, ok)
+			assert.NotEqual(t, test.checked, checked)
+		})
+	}
+}
+
+func builtZ(id int64) *redis.Z {
+	return &redis.Z{Score: float64(time.Now().Unix()), Member: id}
+}
@@ -0,0 +1,167 @@
+package mathcing
+
+import (
+	"context"
+	"flame/internal/mappers"
+	"flame/internal/models"
+	"flame/pkg/events"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
+)
+
+// builtPoolsKey holds the users with a cached candidate pool scored by the time it was built.
+const builtPoolsKey = "pools:built"
+
+// poolsKey is the set of users whose candidate pool contains userId. It may hold users whose pool
+// no longer contains userId, removing from a pool that does not contain the member is a no-op.
+func poolsKey(userId int64) string {
+	return fmt.Sprintf("user:%d:pools", userId)
+}
+
+// HandleAccountEvent keeps cached candidate pools up to date with account changes.
+func (service *Service) HandleAccountEvent(ctx context.Context, event *events.Event) error {
+	switch event.Type {
+	case events.UserRegistered:
+		var payload events.UserRegisteredPayload
+		if err := event.Decode(&payload); err != nil {
+			return err
+		}
+		return service.syncPools(ctx, payload.UserId)
+	case events.ProfileUpdated:
+		var payload events.ProfileUpdatedPayload
+		if err := event.Decode(&payload); err != nil {
+			return err
+		}
+		return service.syncPools(ctx, payload.UserId)
+	case events.LocationChanged:
+		var payload events.LocationChangedPayload
+		if err := event.Decode(&payload); err != nil {
+			return err
+		}
+		return service.syncPools(ctx, payload.UserId)
+	}
+	return nil
+}
+
+// syncPools puts userId into the existing pools of the users whose preferences they match and
+// takes them out of the pools they no longer belong to. Pools that are not cached are left alone,
+// they are built with the user in them on the next request.
+func (service *Service) syncPools(ctx context.Context, userId int64) error {
+	candidate := service.Repository.GetCandidate(userId)
+	err := service.indexLocation(ctx, userId, candidate)
+	if err != nil {
+		return err
+	}
+	if candidate == nil {
+		return service.removeFromPools(ctx, userId, nil)
+	}
+	viewers, err := service.Repository.GetPoolViewers(userId, service.Strict)
+	if err != nil {
+		return err
+	}
+	swipedByIds, err := service.Repository.GetSwipedByIds(userId)
+	if err != nil {
+		return err
+	}
+	likedIds, err := service.Repository.GetLikedIds(userId)
+	if err != nil {
+		return err
+	}
+	swipedBy := make(map[int64]struct{}, len(swipedByIds))
+	for _, id := range swipedByIds {
+		swipedBy[id] = struct{}{}
+	}
+	liked := make(map[int64]struct{}, len(likedIds))
+	for _, id := range likedIds {
+		liked[id] = struct{}{}
+	}
+
+	matching := make([]models.PoolViewer, 0, len(viewers))
+	keep := make(map[int64]struct{}, len(viewers))
+	for _, viewer := range viewers {
+		if _, ok := swipedBy[viewer.Id]; ok {
+			continue
+		}
+		matching = append(matching, viewer)
+		keep[viewer.Id] = struct{}{}
+	}
+	err = service.removeFromPools(ctx, userId, keep)
+	if err != nil {
+		return err
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	pipe := service.Redis.Pipeline()
+	exists := make([]*redis.IntCmd, len(matching))
+	for i, viewer := range matching {
+		exists[i] = pipe.Exists(ctx, fmt.Sprintf("user:%d:candidates", viewer.Id))
+	}
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	pipe = service.Redis.TxPipeline()
+	pipe.HSet(ctx, fmt.Sprintf("user:%d", userId), mappers.FromModelToMapMatchingUser(*candidate))
+	for i, viewer := range matching {
+		if exists[i].Val() == 0 {
+			continue
+		}
+		_, likedViewer := liked[viewer.Id]
+		card := *candidate
+		card.Mismatches = viewer.Mismatches
+		sc := &ScoreContext{
+			LonLat: &models.LonLat{Lon: viewer.Lon, Lat: viewer.Lat},
+			Preferences: &models.UserPreferences{
+				UserId:   viewer.Id,
+				Distance: viewer.Distance,
+				AgeMin:   viewer.AgeMin,
+				AgeMax:   viewer.AgeMax,
+				City:     viewer.City,
+			},
+			Now: now,
+		}
+		if likedViewer {
+			sc.LikedMe = map[int64]struct{}{userId: {}}
+		}
+		pipe.ZAdd(ctx, fmt.Sprintf("user:%d:candidates", viewer.Id), &redis.Z{
+			Score:  service.Pipeline.Score(sc, &card),
+			Member: userId,
+		})
+		pipe.SAdd(ctx, poolsKey(userId), viewer.Id)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// removeFromPools takes userId out of every cached pool except the ones of the users in keep.
+func (service *Service) removeFromPools(ctx context.Context, userId int64, keep map[int64]struct{}) error {
+	viewerIds, err := service.Redis.SMembers(ctx, poolsKey(userId)).Result()
+	if err != nil {
+		return err
+	}
+	pipe := service.Redis.TxPipeline()
+	removed := 0
+	for _, idStr := range viewerIds {
+		viewerId, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		if _, ok := keep[viewerId]; ok {
+			continue
+		}
+		pipe.ZRem(ctx, fmt.Sprintf("user:%d:candidates", viewerId), userId)
+		pipe.SRem(ctx, poolsKey(userId), viewerId)
+		removed++
+	}
+	if removed == 0 {
+		return nil
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
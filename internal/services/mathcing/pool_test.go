@@ -0,0 +1,93 @@
+package mathcing
+
+import (
+	"context"
+	"flame/internal/config"
+	"flame/internal/models"
+	"flame/pkg/events"
+	"flame/tests/mocks"
+	"github.com/go-playground/assert/v2"
+	"github.com/go-redis/redis/v8"
+	"testing"
+)
+
+func TestService_HandleAccountEvent(t *testing.T) {
+	oldPhoto, newPhoto := "https://cdn/old.jpg", "https://cdn/new.jpg"
+	viewer := func(id int64) models.PoolViewer {
+		return models.PoolViewer{Id: id, Lon: 37.62, Lat: 55.76}
+	}
+	tests := []struct {
+		name    string
+		photo   string
+		viewers []models.PoolViewer
+		// pools are the members of the candidate pools of users 1 and 3 after the event.
+		pools map[int64][]string
+		in    []string
+	}{
+		{
+			name:    "preferences changed",
+			photo:   oldPhoto,
+			viewers: []models.PoolViewer{viewer(3)},
+			pools:   map[int64][]string{1: {"5"}, 3: {"2", "6"}},
+			in:      []string{"3"},
+		},
+		{
+			name:    "photo changed",
+			photo:   newPhoto,
+			viewers: []models.PoolViewer{viewer(1)},
+			pools:   map[int64][]string{1: {"2", "5"}, 3: {"6"}},
+			in:      []string{"1"},
+		},
+		{
+			name:    "interested in changed to nobody around",
+			photo:   oldPhoto,
+			viewers: nil,
+			pools:   map[int64][]string{1: {"5"}, 3: {"6"}},
+			in:      nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			server, rdb := mocks.NewRedis(t)
+			repo := new(mocks.MockMatchingRepository)
+			service := &Service{
+				Repository: repo,
+				Redis:      rdb,
+				Pipeline:   NewPipeline(config.ScoringWeights{}, 0),
+				Strict:     true,
+			}
+			// User 2 is in the pool of user 1, user 3 has a pool without them.
+			rdb.ZAdd(ctx, "user:1:candidates", candidateZ(2), candidateZ(5))
+			rdb.ZAdd(ctx, "user:3:candidates", candidateZ(6))
+			rdb.SAdd(ctx, poolsKey(2), 1)
+			rdb.HSet(ctx, "user:2", "photo_url", oldPhoto)
+
+			photo := test.photo
+			repo.On("GetCandidate", int64(2)).Return(&models.GetMatchingUser{
+				User:     models.User{Id: 2, Name: "Anna"},
+				Lon:      37.61,
+				Lat:      55.75,
+				PhotoUrl: &photo,
+			})
+			repo.On("GetPoolViewers", int64(2), true).Return(test.viewers, nil)
+			repo.On("GetSwipedByIds", int64(2)).Return([]int64{}, nil)
+			repo.On("GetLikedIds", int64(2)).Return([]int64{}, nil)
+
+			event, err := events.New(events.AccountStream, events.ProfileUpdated, events.ProfileUpdatedPayload{UserId: 2})
+			assert.Equal(t, nil, err)
+			err = service.HandleAccountEvent(ctx, event)
+			assert.Equal(t, nil, err)
+
+			assert.Equal(t, test.pools[1], server.Members("user:1:candidates"))
+			assert.Equal(t, test.pools[3], server.Members("user:3:candidates"))
+			assert.Equal(t, test.in, server.Members(poolsKey(2)))
+			assert.Equal(t, test.photo, server.Hash("user:2")["photo_url"])
+			assert.Equal(t, regionOf(37.61, 55.75), server.Hash(geoRegionsKey)["2"])
+		})
+	}
+}
+
+func candidateZ(id int64) *redis.Z {
+	return &redis.Z{Score: 1, Member: id}
+}
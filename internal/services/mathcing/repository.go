@@ -3,6 +3,8 @@ package mathcing
 import (
 	"flame/internal/models"
 	"flame/pkg/db"
+	"github.com/lib/pq"
+	"time"
 )
 
 type RepositoryDeps struct {
@@ -21,17 +23,35 @@ func NewRepository(deps *RepositoryDeps) *Repository {
 	}
 }
 
-func (repo *Repository) GetMatchingUsers(userId int64) ([]models.GetMatchingUser, error) {
+// GetMatchingUsers returns the users that match the preferences of userId. Mismatches counts the
+// preferences of the candidate (interested in, age, distance, city) that userId does not meet; in strict
+// mode only candidates without mismatches are returned. When nearbyIds is not nil only those users
+// are considered, the distance is still checked. It is checked on location or travel_location
+// rather than on discovery_location(u1), so the GiST index of each column can be used.
+func (repo *Repository) GetMatchingUsers(userId int64, strict bool, nearbyIds []int64) ([]models.GetMatchingUser, error) {
 	var users []models.GetMatchingUser
 	err := repo.AccountDB.Select(&users,
-		`SELECT u1.id, u1.name, u1.birth_date, u1.city, u1.gender, st_x(ST_AsText(u1.location)::geometry) as lon, st_y(ST_AsText(u1.location)::geometry) as lat, up.photo_url, up.id as photo_id
+		`SELECT * FROM (SELECT u1.id, u1.name, u1.birth_date, u1.city, u1.gender, u1.bio, u1.last_active_at, st_x(ST_AsText(discovery_location(u1))::geometry) as lon, st_y(ST_AsText(discovery_location(u1))::geometry) as lat, up.photo_url, up.id as photo_id, i1.genders as interested_in, is_travelling(u1) as visiting,
+       			(CASE WHEN i1.genders IS NULL OR u.gender::text = ANY(i1.genders) THEN 0 ELSE 1 END +
+       			CASE WHEN (p1.age_min IS NULL OR EXTRACT(YEAR FROM AGE(u.birth_date)) >= p1.age_min) AND (p1.age_max IS NULL OR EXTRACT(YEAR FROM AGE(u.birth_date)) <= p1.age_max) THEN 0 ELSE 1 END +
+       			CASE WHEN p1.distance IS NULL OR st_dwithin(discovery_location(u), discovery_location(u1), p1.distance * 1000) THEN 0 ELSE 1 END +
+       			CASE WHEN p1.city IS NULL OR p1.city='' OR u.city = p1.city THEN 0 ELSE 1 END) as mismatches
        			FROM users u
        			JOIN preferences p ON	u.id = p.user_id
-       			JOIN users u1 ON u1.location IS NOT NULL AND st_dwithin(u1.location, u.location, p.distance * 1000)  AND
-       			(p.age IS NULL OR (EXTRACT(YEAR FROM AGE(u1.birth_date)) BETWEEN  GREATEST(ROUND(p.age * 0.8), 16) AND GREATEST(ROUND(p.age * 1.2),20) )) AND
-						(p.city IS NULL OR p.city='' OR u1.city = p.city) AND (p.gender IS NULL OR u1.gender = p.gender) AND u.id != u1.id
-       			LEFT JOIN user_photos up ON u1.id = up.user_id
-       			WHERE u.id=$1`, userId)
+       			LEFT JOIN LATERAL (SELECT array_agg(gender::text) as genders FROM interested_in WHERE user_id = u.id) i ON true
+       			JOIN users u1 ON u1.location IS NOT NULL AND ($3::bigint[] IS NULL OR u1.id = ANY($3)) AND
+						((NOT is_travelling(u1) AND st_dwithin(u1.location, discovery_location(u), p.distance * 1000)) OR
+						(is_travelling(u1) AND st_dwithin(u1.travel_location, discovery_location(u), p.distance * 1000))) AND
+       			(p.age_min IS NULL OR EXTRACT(YEAR FROM AGE(u1.birth_date)) >= p.age_min) AND
+       			(p.age_max IS NULL OR EXTRACT(YEAR FROM AGE(u1.birth_date)) <= p.age_max) AND
+						(p.city IS NULL OR p.city='' OR u1.city = p.city) AND (i.genders IS NULL OR u1.gender::text = ANY(i.genders)) AND u.id != u1.id AND
+						(u1.status = 'active' OR (u1.status = 'suspended' AND u1.suspended_until <= now()))
+       			LEFT JOIN preferences p1 ON u1.id = p1.user_id
+       			LEFT JOIN LATERAL (SELECT array_agg(gender::text) as genders FROM interested_in WHERE user_id = u1.id) i1 ON true
+       			LEFT JOIN user_photos up ON u1.id = up.user_id AND up.is_main
+       			WHERE u.id=$1 AND NOT EXISTS (SELECT 1 FROM blocks b 
+       				WHERE (b.blocker_id=u.id AND b.blocked_id=u1.id) OR (b.blocker_id=u1.id AND b.blocked_id=u.id))
+       		) candidates WHERE NOT $2 OR mismatches = 0`, userId, strict, pq.Array(nearbyIds))
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +64,13 @@ func (repo *Repository) DeleteDuplicateMatch(userId int64, users []models.GetMat
     CASE 
     	WHEN user_id1=$1 THEN user_id2
     	WHEN user_id2=$1 THEN user_id1
-    END FROM swipes WHERE (user_id1=$1 AND user_is_liked1 IS NOT NULL) OR (user_id2=$1 AND user_is_liked2 IS NOT NULL)`, userId)
+    END FROM swipes WHERE (user_id1=$1 AND user_is_liked1 IS NOT NULL) OR (user_id2=$1 AND user_is_liked2 IS NOT NULL)
+    UNION
+    SELECT
+    CASE
+    	WHEN user_id1=$1 THEN user_id2
+    	WHEN user_id2=$1 THEN user_id1
+    END FROM unmatches WHERE user_id1=$1 OR user_id2=$1`, userId)
 	removeMap := make(map[int64]struct{}, len(removeIds))
 	for _, id := range removeIds {
 		removeMap[id] = struct{}{}
@@ -60,12 +86,216 @@ func (repo *Repository) DeleteDuplicateMatch(userId int64, users []models.GetMat
 	return res
 }
 
+// GetLonLat returns the point userId is matched from: the travel location while a trip lasts, the
+// real location otherwise. Every query here uses discovery_location for the same reason.
 func (repo *Repository) GetLonLat(userId int64) *models.LonLat {
 	var lonLat models.LonLat
 
-	err := repo.AccountDB.Get(&lonLat, `SELECT st_x(ST_AsText(location)::geometry) as lon,st_y(ST_AsText(location)::geometry) as lat FROM users WHERE id=$1`, userId)
+	err := repo.AccountDB.Get(&lonLat, `SELECT st_x(ST_AsText(discovery_location(users))::geometry) as lon,st_y(ST_AsText(discovery_location(users))::geometry) as lat FROM users WHERE id=$1`, userId)
 	if err != nil {
 		return nil
 	}
 	return &lonLat
 }
+
+func (repo *Repository) GetLikes(userId int64, after *models.Like, limit int32) ([]models.Like, error) {
+	var likes []models.Like
+	var afterTime interface{}
+	var afterId int64
+	if after != nil {
+		afterTime = after.LikedAt
+		afterId = after.UserId
+	}
+	err := repo.SwipesDB.Select(&likes, `SELECT user_id, liked_at, kind FROM (
+				SELECT
+				CASE
+					WHEN s.user_id1=$1 THEN s.user_id2
+					WHEN s.user_id2=$1 THEN s.user_id1
+				END AS user_id,
+				COALESCE(CASE
+					WHEN s.user_id1=$1 THEN s.liked_at2
+					WHEN s.user_id2=$1 THEN s.liked_at1
+				END, 'epoch') AS liked_at,
+				COALESCE(CASE
+					WHEN s.user_id1=$1 THEN s.kind2
+					WHEN s.user_id2=$1 THEN s.kind1
+				END, 'like') AS kind
+				FROM swipes s
+				WHERE ((s.user_id1=$1 AND s.user_is_liked2) OR (s.user_id2=$1 AND s.user_is_liked1))
+				AND NOT EXISTS (SELECT 1 FROM unmatches un WHERE un.user_id1=s.user_id1 AND un.user_id2=s.user_id2)
+			) likes
+			WHERE $2::timestamptz IS NULL OR (liked_at, user_id) < ($2::timestamptz, $3::bigint)
+			ORDER BY liked_at DESC, user_id DESC
+			LIMIT $4`, userId, afterTime, afterId, limit)
+	if err != nil {
+		return nil, err
+	}
+	return likes, nil
+}
+
+// GetUsersByIds returns the cards of ids as seen by userId, leaving out restricted accounts and users
+// blocked in either direction. Users without a location are kept, they liked userId all the same.
+func (repo *Repository) GetUsersByIds(userId int64, ids []int64) ([]models.GetMatchingUser, error) {
+	var users []models.GetMatchingUser
+	err := repo.AccountDB.Select(&users,
+		`SELECT u.id, u.name, u.birth_date, u.city, u.gender, COALESCE(st_x(ST_AsText(discovery_location(u))::geometry), 0) as lon, COALESCE(st_y(ST_AsText(discovery_location(u))::geometry), 0) as lat, discovery_location(u) IS NULL as no_location, up.photo_url, up.id as photo_id, is_travelling(u) as visiting
+				FROM users u
+				LEFT JOIN user_photos up ON u.id = up.user_id AND up.is_main
+				WHERE u.id = ANY($2) AND
+					(u.status = 'active' OR (u.status = 'suspended' AND u.suspended_until <= now())) AND NOT EXISTS (SELECT 1 FROM blocks b 
+					WHERE (b.blocker_id=$1 AND b.blocked_id=u.id) OR (b.blocker_id=u.id AND b.blocked_id=$1))`, userId, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (repo *Repository) GetSuperLikerIds(userId int64) ([]int64, error) {
+	var ids []int64
+	err := repo.SwipesDB.Select(&ids, `SELECT
+		CASE
+			WHEN user_id1=$1 THEN user_id2
+			WHEN user_id2=$1 THEN user_id1
+		END FROM swipes
+		WHERE (user_id1=$1 AND kind2='super_like' AND user_is_liked1 IS NULL)
+		OR (user_id2=$1 AND kind1='super_like' AND user_is_liked2 IS NULL)`, userId)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (repo *Repository) GetPreferences(userId int64) *models.UserPreferences {
+	var pref models.UserPreferences
+	err := repo.AccountDB.Get(&pref, `SELECT * FROM preferences WHERE user_id=$1`, userId)
+	if err != nil {
+		return nil
+	}
+	return &pref
+}
+
+// GetLikerIds returns the users whose like to userId is still unanswered.
+func (repo *Repository) GetLikerIds(userId int64) ([]int64, error) {
+	var ids []int64
+	err := repo.SwipesDB.Select(&ids, `SELECT
+		CASE
+			WHEN user_id1=$1 THEN user_id2
+			WHEN user_id2=$1 THEN user_id1
+		END FROM swipes
+		WHERE (user_id1=$1 AND user_is_liked2 AND user_is_liked1 IS NULL)
+		OR (user_id2=$1 AND user_is_liked1 AND user_is_liked2 IS NULL)`, userId)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetCandidate returns the card of userId as it is stored in candidate pools. Restricted users
+// and users without a location are not returned.
+func (repo *Repository) GetCandidate(userId int64) *models.GetMatchingUser {
+	var user models.GetMatchingUser
+	err := repo.AccountDB.Get(&user,
+		`SELECT u.id, u.name, u.birth_date, u.city, u.gender, u.bio, u.last_active_at, st_x(ST_AsText(discovery_location(u))::geometry) as lon, st_y(ST_AsText(discovery_location(u))::geometry) as lat, up.photo_url, up.id as photo_id, is_travelling(u) as visiting,
+				(SELECT array_agg(gender::text) FROM interested_in WHERE user_id = u.id) as interested_in
+				FROM users u
+				LEFT JOIN user_photos up ON u.id = up.user_id AND up.is_main
+				WHERE u.id=$1 AND u.location IS NOT NULL AND
+					(u.status = 'active' OR (u.status = 'suspended' AND u.suspended_until <= now()))`, userId)
+	if err != nil {
+		return nil
+	}
+	return &user
+}
+
+// GetPoolViewers is GetMatchingUsers the other way round: it returns the users whose preferences
+// userId matches. Mismatches counts the preferences of userId the viewer does not meet.
+func (repo *Repository) GetPoolViewers(userId int64, strict bool) ([]models.PoolViewer, error) {
+	var viewers []models.PoolViewer
+	err := repo.AccountDB.Select(&viewers,
+		`SELECT * FROM (SELECT u.id, st_x(ST_AsText(discovery_location(u))::geometry) as lon, st_y(ST_AsText(discovery_location(u))::geometry) as lat, p.distance, p.age_min, p.age_max, p.city,
+       			(CASE WHEN i1.genders IS NULL OR u.gender::text = ANY(i1.genders) THEN 0 ELSE 1 END +
+       			CASE WHEN (p1.age_min IS NULL OR EXTRACT(YEAR FROM AGE(u.birth_date)) >= p1.age_min) AND (p1.age_max IS NULL OR EXTRACT(YEAR FROM AGE(u.birth_date)) <= p1.age_max) THEN 0 ELSE 1 END +
+       			CASE WHEN p1.distance IS NULL OR st_dwithin(discovery_location(u), discovery_location(u1), p1.distance * 1000) THEN 0 ELSE 1 END +
+       			CASE WHEN p1.city IS NULL OR p1.city='' OR u.city = p1.city THEN 0 ELSE 1 END) as mismatches
+       			FROM users u1
+       			LEFT JOIN preferences p1 ON u1.id = p1.user_id
+       			LEFT JOIN LATERAL (SELECT array_agg(gender::text) as genders FROM interested_in WHERE user_id = u1.id) i1 ON true
+       			JOIN users u ON u.id != u1.id AND u.location IS NOT NULL AND
+						(u.status = 'active' OR (u.status = 'suspended' AND u.suspended_until <= now()))
+       			JOIN preferences p ON u.id = p.user_id AND st_dwithin(discovery_location(u1), discovery_location(u), p.distance * 1000) AND
+       			(p.age_min IS NULL OR EXTRACT(YEAR FROM AGE(u1.birth_date)) >= p.age_min) AND
+       			(p.age_max IS NULL OR EXTRACT(YEAR FROM AGE(u1.birth_date)) <= p.age_max) AND
+						(p.city IS NULL OR p.city='' OR u1.city = p.city)
+       			LEFT JOIN LATERAL (SELECT array_agg(gender::text) as genders FROM interested_in WHERE user_id = u.id) i ON true
+       			WHERE u1.id=$1 AND u1.location IS NOT NULL AND (i.genders IS NULL OR u1.gender::text = ANY(i.genders)) AND
+       				NOT EXISTS (SELECT 1 FROM blocks b 
+       				WHERE (b.blocker_id=u.id AND b.blocked_id=u1.id) OR (b.blocker_id=u1.id AND b.blocked_id=u.id))
+       		) viewers WHERE NOT $2 OR mismatches = 0`, userId, strict)
+	if err != nil {
+		return nil, err
+	}
+	return viewers, nil
+}
+
+// GetSwipedByIds returns the users who already swiped userId or were unmatched from them,
+// userId must not be put back into their pools.
+func (repo *Repository) GetSwipedByIds(userId int64) ([]int64, error) {
+	var ids []int64
+	err := repo.SwipesDB.Select(&ids, `SELECT
+		CASE
+			WHEN user_id1=$1 THEN user_id2
+			WHEN user_id2=$1 THEN user_id1
+		END FROM swipes WHERE (user_id1=$1 AND user_is_liked2 IS NOT NULL) OR (user_id2=$1 AND user_is_liked1 IS NOT NULL)
+		UNION
+		SELECT
+		CASE
+			WHEN user_id1=$1 THEN user_id2
+			WHEN user_id2=$1 THEN user_id1
+		END FROM unmatches WHERE user_id1=$1 OR user_id2=$1`, userId)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetLikedIds returns the users userId liked who have not answered yet.
+func (repo *Repository) GetLikedIds(userId int64) ([]int64, error) {
+	var ids []int64
+	err := repo.SwipesDB.Select(&ids, `SELECT
+		CASE
+			WHEN user_id1=$1 THEN user_id2
+			WHEN user_id2=$1 THEN user_id1
+		END FROM swipes
+		WHERE (user_id1=$1 AND user_is_liked1 AND user_is_liked2 IS NULL)
+		OR (user_id2=$1 AND user_is_liked2 AND user_is_liked1 IS NULL)`, userId)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetTravelChangedIds returns the users whose trip started or ended in (since, until].
+func (repo *Repository) GetTravelChangedIds(since, until time.Time) ([]int64, error) {
+	var ids []int64
+	err := repo.AccountDB.Select(&ids,
+		`SELECT id FROM users
+				WHERE (travel_starts_at > $1 AND travel_starts_at <= $2) OR (travel_ends_at > $1 AND travel_ends_at <= $2)`,
+		since, until)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetLocatedUsers returns the location of every user who can appear in candidate pools.
+func (repo *Repository) GetLocatedUsers() ([]models.UserLocation, error) {
+	var users []models.UserLocation
+	err := repo.AccountDB.Select(&users,
+		`SELECT id, st_x(ST_AsText(discovery_location(users))::geometry) as lon, st_y(ST_AsText(discovery_location(users))::geometry) as lat FROM users
+				WHERE location IS NOT NULL AND
+					(status = 'active' OR (status = 'suspended' AND suspended_until <= now()))`)
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
@@ -0,0 +1,199 @@
+package mathcing
+
+import (
+	"flame/internal/config"
+	"flame/internal/models"
+	"github.com/umahmood/haversine"
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	defaultPreferredDistance = 10
+	ageTolerance             = 10
+	activityHalfLife         = 72 * time.Hour
+	preferenceCriteria       = 4
+)
+
+const (
+	// StrictMode only shows candidates whose own preferences the viewer meets.
+	StrictMode = "strict"
+	// SoftMode shows them anyway and lowers their score for every preference the viewer misses.
+	SoftMode = "soft"
+)
+
+// ScoreContext is what the signals know about the user the feed is built for.
+type ScoreContext struct {
+	LonLat      *models.LonLat
+	Preferences *models.UserPreferences
+	LikedMe     map[int64]struct{}
+	Now         time.Time
+}
+
+// Signal scores a candidate in the range [0, 1].
+type Signal interface {
+	Score(sc *ScoreContext, candidate *models.GetMatchingUser) float64
+}
+
+type SignalFunc func(sc *ScoreContext, candidate *models.GetMatchingUser) float64
+
+func (f SignalFunc) Score(sc *ScoreContext, candidate *models.GetMatchingUser) float64 {
+	return f(sc, candidate)
+}
+
+type WeightedSignal struct {
+	Signal Signal
+	Weight float64
+}
+
+// Pipeline ranks candidates by the weighted sum of its signals. The sum is lowered by
+// MismatchPenalty when the viewer misses every preference of the candidate, proportionally otherwise.
+type Pipeline struct {
+	Signals         []WeightedSignal
+	MismatchPenalty float64
+}
+
+var defaultWeights = config.ScoringWeights{
+	Distance:     0.3,
+	Age:          0.2,
+	Completeness: 0.15,
+	Activity:     0.15,
+	LikedMe:      0.2,
+}
+
+func NewPipeline(weights config.ScoringWeights, mismatchPenalty float64) *Pipeline {
+	if weights == (config.ScoringWeights{}) {
+		weights = defaultWeights
+	}
+	return &Pipeline{
+		Signals: []WeightedSignal{
+			{Signal: SignalFunc(distanceSignal), Weight: weights.Distance},
+			{Signal: SignalFunc(ageSignal), Weight: weights.Age},
+			{Signal: SignalFunc(completenessSignal), Weight: weights.Completeness},
+			{Signal: SignalFunc(activitySignal), Weight: weights.Activity},
+			{Signal: SignalFunc(likedMeSignal), Weight: weights.LikedMe},
+		},
+		MismatchPenalty: clamp(mismatchPenalty),
+	}
+}
+
+func (pipeline *Pipeline) Score(sc *ScoreContext, candidate *models.GetMatchingUser) float64 {
+	var score float64
+	for _, s := range pipeline.Signals {
+		if s.Weight == 0 {
+			continue
+		}
+		score += s.Weight * clamp(s.Signal.Score(sc, candidate))
+	}
+	mismatches := float64(candidate.Mismatches) / preferenceCriteria
+	return score * (1 - pipeline.MismatchPenalty*clamp(mismatches))
+}
+
+// Rank sets the score of every candidate and orders them from best to worst.
+// Candidates with equal scores are ordered by id so the order is stable.
+func (pipeline *Pipeline) Rank(sc *ScoreContext, candidates []models.GetMatchingUser) {
+	for i := range candidates {
+		candidates[i].Score = pipeline.Score(sc, &candidates[i])
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Id < candidates[j].Id
+	})
+}
+
+func distanceSignal(sc *ScoreContext, candidate *models.GetMatchingUser) float64 {
+	if sc.LonLat == nil {
+		return 0
+	}
+	maxDistance := float64(defaultPreferredDistance)
+	if sc.Preferences != nil && sc.Preferences.Distance != nil {
+		maxDistance = float64(*sc.Preferences.Distance)
+	}
+	_, km := haversine.Distance(
+		haversine.Coord{Lat: sc.LonLat.Lat, Lon: sc.LonLat.Lon},
+		haversine.Coord{Lat: candidate.Lat, Lon: candidate.Lon},
+	)
+	return 1 - km/maxDistance
+}
+
+func ageSignal(sc *ScoreContext, candidate *models.GetMatchingUser) float64 {
+	if sc.Preferences == nil || candidate.BirthDate == nil {
+		return 0
+	}
+	target, ok := preferredAge(sc.Preferences)
+	if !ok {
+		return 0
+	}
+	age, ok := ageAt(*candidate.BirthDate, sc.Now)
+	if !ok {
+		return 0
+	}
+	return 1 - math.Abs(float64(age)-target)/ageTolerance
+}
+
+func completenessSignal(sc *ScoreContext, candidate *models.GetMatchingUser) float64 {
+	fields := []bool{
+		candidate.PhotoUrl != nil && *candidate.PhotoUrl != "",
+		candidate.BirthDate != nil,
+		candidate.City != nil && *candidate.City != "",
+		candidate.Gender != nil,
+		candidate.Bio != nil && *candidate.Bio != "",
+	}
+	var filled int
+	for _, f := range fields {
+		if f {
+			filled++
+		}
+	}
+	return float64(filled) / float64(len(fields))
+}
+
+func activitySignal(sc *ScoreContext, candidate *models.GetMatchingUser) float64 {
+	if candidate.LastActiveAt == nil {
+		return 0
+	}
+	idle := sc.Now.Sub(*candidate.LastActiveAt)
+	if idle < 0 {
+		return 1
+	}
+	return math.Exp2(-float64(idle) / float64(activityHalfLife))
+}
+
+func likedMeSignal(sc *ScoreContext, candidate *models.GetMatchingUser) float64 {
+	if _, ok := sc.LikedMe[candidate.Id]; ok {
+		return 1
+	}
+	return 0
+}
+
+// preferredAge is the middle of the preferred age range, or its only bound.
+func preferredAge(pref *models.UserPreferences) (float64, bool) {
+	switch {
+	case pref.AgeMin != nil && pref.AgeMax != nil:
+		return float64(*pref.AgeMin+*pref.AgeMax) / 2, true
+	case pref.AgeMin != nil:
+		return float64(*pref.AgeMin), true
+	case pref.AgeMax != nil:
+		return float64(*pref.AgeMax), true
+	}
+	return 0, false
+}
+
+func ageAt(birthDate string, now time.Time) (int, bool) {
+	birthTime, err := time.Parse(time.RFC3339, birthDate)
+	if err != nil {
+		return 0, false
+	}
+	age := now.Year() - birthTime.Year()
+	if now.YearDay() < birthTime.YearDay() {
+		age--
+	}
+	return age, true
+}
+
+func clamp(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,50 @@
+package mathcing
+
+import (
+	"flame/internal/config"
+	"flame/internal/models"
+	"github.com/go-playground/assert/v2"
+	"testing"
+	"time"
+)
+
+func TestPipelineRank(t *testing.T) {
+	now := time.Now()
+	active := now.Add(-time.Hour)
+	bio := "bio"
+	sc := &ScoreContext{
+		LonLat:  &models.LonLat{Lon: 37.6173, Lat: 55.7558},
+		LikedMe: map[int64]struct{}{3: {}},
+		Now:     now,
+	}
+	candidates := []models.GetMatchingUser{
+		{User: models.User{Id: 1}, Lon: 37.6173, Lat: 55.7558},
+		{User: models.User{Id: 2}, Lon: 37.6173, Lat: 55.7558},
+		{User: models.User{Id: 3, Bio: &bio, LastActiveAt: &active}, Lon: 37.6173, Lat: 55.7558},
+		{User: models.User{Id: 4}, Lon: 30.3141, Lat: 59.9386},
+	}
+	NewPipeline(config.ScoringWeights{}, 0).Rank(sc, candidates)
+
+	ids := make([]int64, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.Id)
+	}
+	assert.Equal(t, []int64{3, 1, 2, 4}, ids)
+	assert.Equal(t, candidates[1].Score, candidates[2].Score)
+}
+
+func TestPipelineMismatchPenalty(t *testing.T) {
+	sc := &ScoreContext{
+		LonLat:  &models.LonLat{Lon: 37.6173, Lat: 55.7558},
+		LikedMe: map[int64]struct{}{},
+		Now:     time.Now(),
+	}
+	pipeline := NewPipeline(config.ScoringWeights{}, 0.5)
+	candidate := models.GetMatchingUser{User: models.User{Id: 1}, Lon: 37.6173, Lat: 55.7558}
+	score := pipeline.Score(sc, &candidate)
+
+	candidate.Mismatches = 2
+	assert.Equal(t, pipeline.Score(sc, &candidate), score*0.75)
+	candidate.Mismatches = 4
+	assert.Equal(t, pipeline.Score(sc, &candidate), score*0.5)
+}
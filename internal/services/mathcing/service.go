@@ -2,28 +2,48 @@ package mathcing
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"flame/internal/config"
 	"flame/internal/interfaces"
 	"flame/internal/mappers"
 	"flame/internal/models"
 	"flame/pkg/db"
 	http_errors "flame/pkg/errors"
 	"fmt"
+	"github.com/go-redis/redis/v8"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultLikesPageSize = 20
+	maxLikesPageSize     = 50
+
+	defaultCandidatesPageSize = 20
+	maxCandidatesPageSize     = 100
+	feedSessionTTL            = time.Hour
 )
 
 type ServiceDeps struct {
 	Repository interfaces.MatchingRepository
 	Logger     *slog.Logger
 	Redis      *db.Redis
+	Config     *config.Config
 }
 type Service struct {
 	Logger     *slog.Logger
 	Repository interfaces.MatchingRepository
 	Redis      *db.Redis
+	Pipeline   *Pipeline
+	Strict     bool
 }
 
 func NewService(deps *ServiceDeps) *Service {
@@ -31,64 +51,254 @@ func NewService(deps *ServiceDeps) *Service {
 		Logger:     deps.Logger,
 		Repository: deps.Repository,
 		Redis:      deps.Redis,
+		Pipeline:   NewPipeline(deps.Config.Matching.Weights, deps.Config.Matching.MismatchPenalty),
+		Strict:     deps.Config.Matching.Mode != SoftMode,
 	}
 }
 
-func (service *Service) GetMatchingUsers(userId int64, location string) ([]models.GetMatchingUser, *models.LonLat, error) {
+// GetMatchingUsers returns one page of the candidate feed. The first page takes a snapshot of the
+// ranked pool for the session, later pages are read from that snapshot so the order stays the same
+// and no candidate is shown twice even if the pool is rebuilt in the meantime.
+func (service *Service) GetMatchingUsers(userId int64, location string, pageSize int32, pageToken string) ([]models.GetMatchingUser, *models.LonLat, string, error) {
 	ctx := context.Background()
+	if pageSize <= 0 {
+		pageSize = defaultCandidatesPageSize
+	}
+	if pageSize > maxCandidatesPageSize {
+		pageSize = maxCandidatesPageSize
+	}
 	lonLat := service.Repository.GetLonLat(userId)
 	if lonLat == nil {
-		return nil, nil, status.Errorf(codes.InvalidArgument, http_errors.LocationIsInvalid)
+		return nil, nil, "", status.Errorf(codes.InvalidArgument, http_errors.LocationIsInvalid)
 	}
 	candidatesKey := fmt.Sprintf("user:%d:candidates", userId)
-	length, err := service.Redis.SCard(ctx, candidatesKey).Result()
-	if err != nil {
-		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.SCard"))
-	}
-	if length == 0 || err != nil {
-		users, err := service.Repository.GetMatchingUsers(userId)
-		validUsers := service.Repository.DeleteDuplicateMatch(userId, users)
+	var session string
+	var offset int64
+	if pageToken == "" {
+		err := service.ensurePool(ctx, userId, lonLat, candidatesKey)
 		if err != nil {
-			service.Logger.Error(err.Error(),
-				slog.String("Error location", "service.Repository.GetMatchingUsers"),
-			)
-			return nil, nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+			return nil, nil, "", err
 		}
-		err = service.addUsersToRedis(candidatesKey, validUsers)
+		session, err = service.createFeedSession(ctx, userId, candidatesKey)
 		if err != nil {
-			service.Logger.Error(err.Error(), slog.String("Error location", "service.AddUsersToRedis"))
+			return nil, nil, "", status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
 		}
-		return validUsers, lonLat, nil
 	} else {
-		users := service.GetUsersFromRedis(ctx, candidatesKey)
-		return users, lonLat, nil
+		var err error
+		session, offset, err = decodeCandidatesPageToken(pageToken)
+		if err != nil {
+			return nil, nil, "", status.Errorf(codes.InvalidArgument, http_errors.InvalidPageToken)
+		}
 	}
+	feedKey := fmt.Sprintf("user:%d:feed:%s", userId, session)
+	total, err := service.Redis.LLen(ctx, feedKey).Result()
+	if err != nil {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.LLen"))
+		return nil, nil, "", status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	if total == 0 {
+		if pageToken != "" {
+			return nil, nil, "", status.Errorf(codes.InvalidArgument, http_errors.InvalidPageToken)
+		}
+		return nil, lonLat, "", nil
+	}
+	candidateIds, err := service.Redis.LRange(ctx, feedKey, offset, offset+int64(pageSize)-1).Result()
+	if err != nil {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.LRange"))
+		return nil, nil, "", status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	users := service.GetUsersFromRedis(ctx, candidatesKey, candidateIds)
+	users = service.withoutRestricted(ctx, users)
+	service.markSuperLikes(userId, users)
+
+	var nextPageToken string
+	if offset+int64(pageSize) < total {
+		nextPageToken = encodeCandidatesPageToken(session, offset+int64(pageSize))
+	}
+	return users, lonLat, nextPageToken, nil
 }
 
-func (service *Service) GetUsersFromRedis(ctx context.Context, candidatesKey string) []models.GetMatchingUser {
+// ensurePool builds the ranked candidate pool if it is empty.
+func (service *Service) ensurePool(ctx context.Context, userId int64, lonLat *models.LonLat, candidatesKey string) error {
+	length, err := service.Redis.ZCard(ctx, candidatesKey).Result()
+	if err != nil {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.ZCard"))
+	}
+	if length != 0 && err == nil {
+		return nil
+	}
+	users, err := service.findCandidates(ctx, userId, lonLat)
+	validUsers := service.Repository.DeleteDuplicateMatch(userId, users)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetMatchingUsers"),
+		)
+		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	service.rank(userId, lonLat, validUsers)
+	service.Redis.Del(ctx, candidatesKey)
+	err = service.addUsersToRedis(userId, candidatesKey, validUsers)
+	if err != nil {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.AddUsersToRedis"))
+	}
+	return nil
+}
 
-	candidateIds, err := service.Redis.SRandMemberN(ctx, candidatesKey, 100).Result()
+// createFeedSession stores the current order of the pool, super likers first, and returns the session id.
+func (service *Service) createFeedSession(ctx context.Context, userId int64, candidatesKey string) (string, error) {
+	candidateIds, err := service.Redis.ZRevRange(ctx, candidatesKey, 0, -1).Result()
 	if err != nil {
-		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.LRange"))
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.ZRevRange"))
+		return "", err
+	}
+	session, err := newFeedSession()
+	if err != nil {
+		service.Logger.Error(err.Error(), slog.String("Error location", "newFeedSession"))
+		return "", err
+	}
+	if len(candidateIds) == 0 {
+		return session, nil
+	}
+	candidateIds = service.superLikesFirst(userId, candidateIds)
+	members := make([]interface{}, len(candidateIds))
+	for i, id := range candidateIds {
+		members[i] = id
+	}
+	feedKey := fmt.Sprintf("user:%d:feed:%s", userId, session)
+	_, err = service.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.RPush(ctx, feedKey, members...)
+		pipe.Expire(ctx, feedKey, feedSessionTTL)
 		return nil
+	})
+	if err != nil {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.TxPipelined"))
+		return "", err
 	}
-	var users []models.GetMatchingUser
+	return session, nil
+}
 
-	for _, candidateIdStr := range candidateIds {
-		candidateId, _ := strconv.Atoi(candidateIdStr)
-		candidateKey := fmt.Sprintf("user:%d", candidateId)
-		candidateData, err := service.Redis.HGetAll(ctx, candidateKey).Result()
-		if err != nil {
-			service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.HGetAll"))
-			return nil
+// withoutRestricted drops cached candidates whose account has been banned or suspended since
+// the candidate set was built. The status keys are written by the account service, active users
+// have no key or an "active" one.
+func (service *Service) withoutRestricted(ctx context.Context, users []models.GetMatchingUser) []models.GetMatchingUser {
+	if len(users) == 0 {
+		return users
+	}
+	pipe := service.Redis.Pipeline()
+	cmds := make([]*redis.StringCmd, len(users))
+	for i, user := range users {
+		cmds[i] = pipe.Get(ctx, fmt.Sprintf("user:%d:status", user.Id))
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.Pipeline.Exec"))
+		return users
+	}
+	res := users[:0]
+	for i, user := range users {
+		userStatus := cmds[i].Val()
+		if userStatus == "" || userStatus == string(models.StatusActive) {
+			res = append(res, user)
+		}
+	}
+	return res
+}
+
+// superLikesFirst moves candidates who super liked the user to the top of the deck.
+func (service *Service) superLikesFirst(userId int64, candidateIds []string) []string {
+	superLikers := service.getSuperLikers(userId)
+	if len(superLikers) == 0 {
+		return candidateIds
+	}
+	first := make([]string, 0, len(superLikers))
+	rest := make([]string, 0, len(candidateIds))
+	for _, idStr := range candidateIds {
+		id, _ := strconv.ParseInt(idStr, 10, 64)
+		if _, ok := superLikers[id]; ok {
+			first = append(first, idStr)
+		} else {
+			rest = append(rest, idStr)
+		}
+	}
+	return append(first, rest...)
+}
+
+// markSuperLikes marks candidates who super liked the user.
+func (service *Service) markSuperLikes(userId int64, users []models.GetMatchingUser) {
+	superLikers := service.getSuperLikers(userId)
+	for i := range users {
+		if _, ok := superLikers[users[i].Id]; ok {
+			users[i].IsSuperLike = true
+		}
+	}
+}
+
+func (service *Service) getSuperLikers(userId int64) map[int64]struct{} {
+	superLikerIds, err := service.Repository.GetSuperLikerIds(userId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetSuperLikerIds"),
+			slog.Int64("UserId", userId),
+		)
+		return nil
+	}
+	superLikers := make(map[int64]struct{}, len(superLikerIds))
+	for _, id := range superLikerIds {
+		superLikers[id] = struct{}{}
+	}
+	return superLikers
+}
+
+// GetUsersFromRedis loads the cards of the given candidates. Candidates that have left the pool
+// since the feed session was created, because they were swiped or rebuilt away, are skipped.
+func (service *Service) GetUsersFromRedis(ctx context.Context, candidatesKey string, candidateIds []string) []models.GetMatchingUser {
+	if len(candidateIds) == 0 {
+		return nil
+	}
+	pipe := service.Redis.Pipeline()
+	scores := make([]*redis.FloatCmd, len(candidateIds))
+	cards := make([]*redis.StringStringMapCmd, len(candidateIds))
+	for i, candidateId := range candidateIds {
+		scores[i] = pipe.ZScore(ctx, candidatesKey, candidateId)
+		cards[i] = pipe.HGetAll(ctx, fmt.Sprintf("user:%s", candidateId))
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil && !errors.Is(err, redis.Nil) {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.Pipeline.Exec"))
+		return nil
+	}
+	users := make([]models.GetMatchingUser, 0, len(candidateIds))
+	for i := range candidateIds {
+		if scores[i].Err() != nil || len(cards[i].Val()) == 0 {
+			continue
 		}
-		user := mappers.FromMapToModelMatchingUser(candidateData)
-		users = append(users, user)
+		users = append(users, mappers.FromMapToModelMatchingUser(cards[i].Val()))
 	}
 	return users
 }
 
-func (service *Service) addUsersToRedis(candidatesKey string, users []models.GetMatchingUser) error {
+// rank scores the candidates for userId with the scoring pipeline.
+func (service *Service) rank(userId int64, lonLat *models.LonLat, users []models.GetMatchingUser) {
+	likerIds, err := service.Repository.GetLikerIds(userId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetLikerIds"),
+			slog.Int64("UserId", userId),
+		)
+	}
+	likedMe := make(map[int64]struct{}, len(likerIds))
+	for _, id := range likerIds {
+		likedMe[id] = struct{}{}
+	}
+	service.Pipeline.Rank(&ScoreContext{
+		LonLat:      lonLat,
+		Preferences: service.Repository.GetPreferences(userId),
+		LikedMe:     likedMe,
+		Now:         time.Now(),
+	}, users)
+}
+
+func (service *Service) addUsersToRedis(userId int64, candidatesKey string, users []models.GetMatchingUser) error {
 	for _, user := range users {
 		userKey := fmt.Sprintf("user:%d", user.Id)
 
@@ -96,10 +306,24 @@ func (service *Service) addUsersToRedis(candidatesKey string, users []models.Get
 		if err != nil {
 			service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.HSet"))
 		}
-		err = service.Redis.SAdd(context.Background(), candidatesKey, user.Id).Err()
+		err = service.Redis.ZAdd(context.Background(), candidatesKey, &redis.Z{
+			Score:  user.Score,
+			Member: user.Id,
+		}).Err()
 		if err != nil {
-			service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.RPush"))
+			service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.ZAdd"))
 		}
+		err = service.Redis.SAdd(context.Background(), poolsKey(user.Id), userId).Err()
+		if err != nil {
+			service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.SAdd"))
+		}
+	}
+	err := service.Redis.ZAdd(context.Background(), builtPoolsKey, &redis.Z{
+		Score:  float64(time.Now().Unix()),
+		Member: userId,
+	}).Err()
+	if err != nil {
+		service.Logger.Error(err.Error(), slog.String("Error location", "service.Redis.ZAdd"))
 	}
 	return nil
 }
@@ -122,7 +346,7 @@ func (service *Service) UpdateRedis(userId int64) error {
 		)
 		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
 	}
-	users, err := service.Repository.GetMatchingUsers(userId)
+	users, err := service.findCandidates(ctx, userId, lonLat)
 	validUsers := service.Repository.DeleteDuplicateMatch(userId, users)
 	if err != nil {
 		service.Logger.Error(err.Error(),
@@ -130,9 +354,137 @@ func (service *Service) UpdateRedis(userId int64) error {
 		)
 		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
 	}
-	err = service.addUsersToRedis(candidatesKey, validUsers)
+	service.rank(userId, lonLat, validUsers)
+	err = service.addUsersToRedis(userId, candidatesKey, validUsers)
 	if err != nil {
 		service.Logger.Error(err.Error(), slog.String("Error location", "service.AddUsersToRedis"))
 	}
 	return nil
 }
+
+func (service *Service) GetLikes(userId int64, pageSize int32, pageToken string) ([]models.LikeCard, *models.LonLat, string, error) {
+	if pageSize <= 0 {
+		pageSize = defaultLikesPageSize
+	}
+	if pageSize > maxLikesPageSize {
+		pageSize = maxLikesPageSize
+	}
+	var after *models.Like
+	if pageToken != "" {
+		like, err := decodeLikesPageToken(pageToken)
+		if err != nil {
+			return nil, nil, "", status.Errorf(codes.InvalidArgument, http_errors.InvalidPageToken)
+		}
+		after = like
+	}
+	lonLat := service.Repository.GetLonLat(userId)
+	likes, err := service.Repository.GetLikes(userId, after, pageSize)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetLikes"),
+			slog.Int64("UserId", userId),
+		)
+		return nil, nil, "", status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	if len(likes) == 0 {
+		return nil, lonLat, "", nil
+	}
+	ids := make([]int64, len(likes))
+	for i, like := range likes {
+		ids[i] = like.UserId
+	}
+	users, err := service.Repository.GetUsersByIds(userId, ids)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetUsersByIds"),
+			slog.Int64("UserId", userId),
+		)
+		return nil, nil, "", status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	usersMap := make(map[int64]models.GetMatchingUser, len(users))
+	for _, user := range users {
+		usersMap[user.Id] = user
+	}
+	cards := make([]models.LikeCard, 0, len(likes))
+	for _, like := range likes {
+		user, ok := usersMap[like.UserId]
+		if !ok {
+			continue
+		}
+		user.IsSuperLike = like.Kind == models.SwipeSuperLike
+		cards = append(cards, models.LikeCard{
+			User:    user,
+			LikedAt: like.LikedAt,
+			Kind:    like.Kind,
+		})
+	}
+	var nextPageToken string
+	if len(likes) == int(pageSize) {
+		nextPageToken = encodeLikesPageToken(likes[len(likes)-1])
+	}
+	return cards, lonLat, nextPageToken, nil
+}
+
+func encodeLikesPageToken(like models.Like) string {
+	token := fmt.Sprintf("%d:%d", like.LikedAt.UnixNano(), like.UserId)
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+func decodeLikesPageToken(pageToken string) (*models.Like, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.Split(string(data), ":")
+	if len(parts) != 2 {
+		return nil, errors.New("malformed page token")
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	userId, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Like{
+		UserId:  userId,
+		LikedAt: time.Unix(0, nanos),
+	}, nil
+}
+
+func newFeedSession() (string, error) {
+	b := make([]byte, 8)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func encodeCandidatesPageToken(session string, offset int64) string {
+	token := fmt.Sprintf("%s:%d", session, offset)
+	return base64.RawURLEncoding.EncodeToString([]byte(token))
+}
+
+func decodeCandidatesPageToken(pageToken string) (string, int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return "", 0, err
+	}
+	parts := strings.Split(string(data), ":")
+	if len(parts) != 2 || parts[0] == "" {
+		return "", 0, errors.New("malformed page token")
+	}
+	if _, err := hex.DecodeString(parts[0]); err != nil {
+		return "", 0, err
+	}
+	offset, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, err
+	}
+	if offset < 0 {
+		return "", 0, errors.New("malformed page token")
+	}
+	return parts[0], offset, nil
+}
@@ -0,0 +1,91 @@
+package mathcing
+
+import (
+	"flame/internal/models"
+	"github.com/go-playground/assert/v2"
+	"testing"
+	"time"
+)
+
+func TestLikesPageToken(t *testing.T) {
+	like := models.Like{
+		UserId:  42,
+		LikedAt: time.Unix(0, 1742472000123456789),
+	}
+	tests := []struct {
+		name  string
+		token string
+		res   *models.Like
+		isErr bool
+	}{
+		{
+			name:  "success",
+			token: encodeLikesPageToken(like),
+			res:   &like,
+			isErr: false,
+		},
+		{
+			name:  "not base64",
+			token: "%%%",
+			res:   nil,
+			isErr: true,
+		},
+		{
+			name:  "malformed",
+			token: "MTIz",
+			res:   nil,
+			isErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := decodeLikesPageToken(tt.token)
+			assert.Equal(t, err != nil, tt.isErr)
+			if tt.res != nil {
+				assert.Equal(t, res.UserId, tt.res.UserId)
+				assert.Equal(t, res.LikedAt.Equal(tt.res.LikedAt), true)
+			}
+		})
+	}
+}
+
+func TestCandidatesPageToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		session string
+		offset  int64
+		isErr   bool
+	}{
+		{
+			name:    "success",
+			token:   encodeCandidatesPageToken("9f86d081884c7d65", 40),
+			session: "9f86d081884c7d65",
+			offset:  40,
+			isErr:   false,
+		},
+		{
+			name:  "not base64",
+			token: "%%%",
+			isErr: true,
+		},
+		{
+			name:  "session is not hex",
+			token: encodeCandidatesPageToken("session", 20),
+			isErr: true,
+		},
+		{
+			name:  "negative offset",
+			token: encodeCandidatesPageToken("9f86d081884c7d65", -20),
+			isErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session, offset, err := decodeCandidatesPageToken(tt.token)
+			assert.Equal(t, err != nil, tt.isErr)
+			assert.Equal(t, session, tt.session)
+			assert.Equal(t, offset, tt.offset)
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package swipes
 
 import (
+	"context"
 	"flame/internal/config"
 	"flame/pkg/db"
+	"flame/pkg/events"
+	grpc_conn "flame/pkg/grpc-conn"
 	"flame/pkg/pb"
 	"google.golang.org/grpc"
 	"log/slog"
@@ -50,15 +53,37 @@ func (app *App) Run() error {
 		DB:    app.DB,
 		Redis: app.Redis,
 	})
+	accountConn, err := grpc_conn.NewClientConn(app.Config.Services.Account.Address)
+	if err != nil {
+		app.Logger.Error(err.Error(),
+			slog.String("Error location", "grpc_conn.NewClientConn"),
+			slog.String("Account address", app.Config.Services.Account.Address),
+		)
+		return err
+	}
 	service := NewService(&ServiceDeps{
-		Repository: repository,
-		Logger:     app.Logger,
+		Repository:    repository,
+		Logger:        app.Logger,
+		Config:        app.Config,
+		AccountClient: pb.NewAccountClient(accountConn),
 	})
 	handler := NewHandler(&HandlerDeps{
 		Logger:  app.Logger,
 		Config:  app.Config,
 		Service: service,
 	})
+	relay := events.NewRelay(&events.RelayDeps{
+		DB:        app.DB,
+		Redis:     app.Redis,
+		Logger:    app.Logger,
+		Interval:  app.Config.Events.RelayInterval,
+		BatchSize: app.Config.Events.RelayBatchSize,
+		MaxLen:    app.Config.Events.StreamMaxLen,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go relay.Run(ctx)
+
 	server := grpc.NewServer(opts...)
 	defer server.Stop()
 	pb.RegisterSwipesServer(server, handler)
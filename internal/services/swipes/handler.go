@@ -4,14 +4,17 @@ import (
 	"context"
 	"flame/internal/config"
 	"flame/internal/interfaces"
+	"flame/internal/mappers"
+	grpc_conn "flame/pkg/grpc-conn"
 	"flame/pkg/pb"
 	"log/slog"
 )
 
 type Handler struct {
-	Logger  *slog.Logger
-	Config  *config.Config
-	Service interfaces.SwipesService
+	Logger     *slog.Logger
+	Config     *config.Config
+	Service    interfaces.SwipesService
+	ChatClient pb.ChatClient
 	pb.UnsafeSwipesServer
 }
 
@@ -22,24 +25,139 @@ type HandlerDeps struct {
 }
 
 func NewHandler(deps *HandlerDeps) *Handler {
+	chatConn, err := grpc_conn.NewClientConn(deps.Config.Services.Chat.Address)
+	if err != nil {
+		deps.Logger.Error(err.Error(),
+			slog.String("Error location", "NewSwipesHandler.grpc_conn.NewClientConn"),
+			slog.String("Chat address", deps.Config.Services.Chat.Address),
+		)
+		return nil
+	}
+	chatClient := pb.NewChatClient(chatConn)
+
 	return &Handler{
-		Logger:  deps.Logger,
-		Config:  deps.Config,
-		Service: deps.Service,
+		Logger:     deps.Logger,
+		Config:     deps.Config,
+		Service:    deps.Service,
+		ChatClient: chatClient,
 	}
 }
 
 func (handler *Handler) CreateOrUpdateSwipe(ctx context.Context, r *pb.CreateOrUpdateSwipeReq) (*pb.CreateOrUpdateSwipeRes, error) {
-	err := handler.Service.CreateOrUpdate(r.UserId1, r.UserId2, r.IsLike)
+	match, err := handler.Service.CreateOrUpdate(r.UserId1, r.UserId2, mappers.FromGrpcSwipeReqToKind(r))
 	if err != nil {
 		return nil, err
 	}
-	return &pb.CreateOrUpdateSwipeRes{}, nil
+	if match == nil {
+		return &pb.CreateOrUpdateSwipeRes{}, nil
+	}
+	return &pb.CreateOrUpdateSwipeRes{
+		IsMatch: true,
+		MatchId: &match.Id,
+	}, nil
 }
 
 func (handler *Handler) GetUnreadSwipes(ctx context.Context, r *pb.GetUnreadSwipesReq) (*pb.GetUnreadSwipesRes, error) {
 	ids := handler.Service.GetUnreadSwipes(r.UserId)
 	return &pb.GetUnreadSwipesRes{
 		UserIds: ids,
+		Count:   int64(len(ids)),
+	}, nil
+}
+
+func (handler *Handler) CountUnreadSwipes(ctx context.Context, r *pb.CountUnreadSwipesReq) (*pb.CountUnreadSwipesRes, error) {
+	count, err := handler.Service.CountUnreadSwipes(r.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CountUnreadSwipesRes{
+		Count: count,
+	}, nil
+}
+
+func (handler *Handler) GetMatches(ctx context.Context, r *pb.GetMatchesReq) (*pb.GetMatchesRes, error) {
+	matches := handler.Service.GetMatches(r.UserId)
+	return &pb.GetMatchesRes{
+		Matches: mappers.FromModelMatchesToGrpc(matches, r.UserId),
+	}, nil
+}
+
+func (handler *Handler) GetMatch(ctx context.Context, r *pb.GetMatchReq) (*pb.GetMatchRes, error) {
+	match, err := handler.Service.GetMatch(r.UserId, r.MatchId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMatchRes{
+		Match: mappers.FromModelMatchToGrpc(*match, r.UserId),
+	}, nil
+}
+
+func (handler *Handler) Unmatch(ctx context.Context, r *pb.UnmatchReq) (*pb.UnmatchRes, error) {
+	partnerId, err := handler.Service.Unmatch(r.UserId, r.MatchId)
+	if err != nil {
+		return nil, err
+	}
+	_, err = handler.ChatClient.DeleteConversation(ctx, &pb.DeleteConversationReq{
+		UserId1: r.UserId,
+		UserId2: partnerId,
+	})
+	if err != nil {
+		handler.Logger.Error(err.Error(),
+			slog.String("Error location", "handler.ChatClient.DeleteConversation"),
+			slog.Int64("UserId1", r.UserId),
+			slog.Int64("UserId2", partnerId),
+		)
+	}
+	return &pb.UnmatchRes{
+		UserId: partnerId,
+	}, nil
+}
+
+func (handler *Handler) IsMatched(ctx context.Context, r *pb.IsMatchedReq) (*pb.IsMatchedRes, error) {
+	return &pb.IsMatchedRes{
+		IsMatched: handler.Service.IsMatched(r.UserId1, r.UserId2),
 	}, nil
 }
+
+func (handler *Handler) Rewind(ctx context.Context, r *pb.RewindReq) (*pb.RewindRes, error) {
+	userId, err := handler.Service.Rewind(r.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RewindRes{
+		UserId: userId,
+	}, nil
+}
+
+func (handler *Handler) GetQuota(ctx context.Context, r *pb.GetQuotaReq) (*pb.GetQuotaRes, error) {
+	likes, superLikes, err := handler.Service.GetQuota(r.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetQuotaRes{
+		Likes:      mappers.FromModelQuotaToGrpc(likes),
+		SuperLikes: mappers.FromModelQuotaToGrpc(superLikes),
+	}, nil
+}
+
+func (handler *Handler) BlockPair(ctx context.Context, r *pb.BlockPairReq) (*pb.BlockPairRes, error) {
+	match, err := handler.Service.BlockPair(r.UserId, r.TargetId)
+	if err != nil {
+		return nil, err
+	}
+	if match == nil {
+		return &pb.BlockPairRes{}, nil
+	}
+	_, err = handler.ChatClient.DeleteConversation(ctx, &pb.DeleteConversationReq{
+		UserId1: r.UserId,
+		UserId2: r.TargetId,
+	})
+	if err != nil {
+		handler.Logger.Error(err.Error(),
+			slog.String("Error location", "handler.ChatClient.DeleteConversation"),
+			slog.Int64("UserId1", r.UserId),
+			slog.Int64("UserId2", r.TargetId),
+		)
+	}
+	return &pb.BlockPairRes{}, nil
+}
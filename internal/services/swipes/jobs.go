@@ -0,0 +1,54 @@
+package swipes
+
+import (
+	"context"
+	"flame/pkg/scheduler"
+	"log/slog"
+	"time"
+)
+
+// defaultOutboxRetention is used when the config leaves it at zero, which would delete events the
+// relay has not published yet.
+const defaultOutboxRetention = 7 * 24 * time.Hour
+
+type JobsDeps struct {
+	Repository      *Repository
+	Logger          *slog.Logger
+	OutboxRetention time.Duration
+}
+
+func NewJobs(deps *JobsDeps) []scheduler.Job {
+	outboxRetention := deps.OutboxRetention
+	if outboxRetention <= 0 {
+		outboxRetention = defaultOutboxRetention
+	}
+	return []scheduler.Job{
+		{
+			Name:     "swipes.trim_likes",
+			Schedule: scheduler.Every(time.Hour),
+			Timeout:  10 * time.Minute,
+			Retries:  1,
+			Run: func(ctx context.Context) error {
+				removed, err := deps.Repository.TrimLikes(ctx, time.Now().Add(-likesWindow))
+				if err != nil {
+					return err
+				}
+				deps.Logger.Info("Likes trimmed", slog.Int64("Removed", removed))
+				return nil
+			},
+		},
+		{
+			Name:     "swipes.purge_outbox",
+			Schedule: scheduler.MustCron("30 3 * * *"),
+			Retries:  2,
+			Run: func(ctx context.Context) error {
+				removed, err := deps.Repository.PurgeOutbox(time.Now().Add(-outboxRetention))
+				if err != nil {
+					return err
+				}
+				deps.Logger.Info("Outbox purged", slog.String("Service", "Swipes"), slog.Int64("Removed", removed))
+				return nil
+			},
+		},
+	}
+}
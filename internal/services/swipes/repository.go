@@ -2,8 +2,15 @@ package swipes
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"flame/internal/models"
 	"flame/pkg/db"
+	"flame/pkg/events"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
 )
 
 type RepositoryDeps struct {
@@ -22,20 +29,51 @@ func NewRepository(deps *RepositoryDeps) *Repository {
 	}
 }
 
-func (repo *Repository) CreateOrUpdate(userId1, userId2 int64, isLike bool) error {
+func (repo *Repository) CreateOrUpdate(userId1, userId2 int64, kind models.SwipeKind) error {
 	var query string
+	swiperId := userId1
+	targetId := userId2
 	if userId1 > userId2 {
 		id1 := userId1
 		userId1 = userId2
 		userId2 = id1
-		query = "INSERT INTO swipes (user_id1, user_id2, user_is_liked2) VALUES($1,$2,$3) ON CONFLICT (user_id1, user_id2) DO UPDATE SET user_is_liked2=$3"
+		query = `INSERT INTO swipes (user_id1, user_id2, user_is_liked2, liked_at2, kind2, first_swiper_id, first_swiped_at) 
+			VALUES($1,$2,$3, CASE WHEN $3 THEN now() END, $5, $4, now()) 
+			ON CONFLICT (user_id1, user_id2) DO UPDATE SET user_is_liked2=$3, liked_at2=CASE WHEN $3 THEN now() END, kind2=$5, updated_at=now()`
 	} else {
-		query = "INSERT INTO swipes (user_id1, user_id2, user_is_liked1) VALUES($1,$2,$3) ON CONFLICT (user_id1, user_id2) DO UPDATE SET user_is_liked1=$3"
+		query = `INSERT INTO swipes (user_id1, user_id2, user_is_liked1, liked_at1, kind1, first_swiper_id, first_swiped_at) 
+			VALUES($1,$2,$3, CASE WHEN $3 THEN now() END, $5, $4, now()) 
+			ON CONFLICT (user_id1, user_id2) DO UPDATE SET user_is_liked1=$3, liked_at1=CASE WHEN $3 THEN now() END, kind1=$5, updated_at=now()`
 	}
-	_, err := repo.DB.Exec(query, userId1, userId2, isLike)
-	return err
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	_, err = tr.Exec(query, userId1, userId2, kind.IsLike(), swiperId, kind)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	_, err = tr.Exec(`INSERT INTO swipe_history (swiper_id, target_id, is_like, kind) VALUES ($1,$2,$3,$4)`,
+		swiperId, targetId, kind.IsLike(), kind)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	err = events.Publish(tr, events.SwipesStream, events.Swiped, events.SwipedPayload{
+		SwiperId: swiperId,
+		TargetId: targetId,
+		Kind:     string(kind),
+	})
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	return tr.Commit()
 }
 
+// GetUnreadSwipes returns the users who liked userId and are still waiting
+// for an answer. Incoming dislikes are never returned.
 func (repo *Repository) GetUnreadSwipes(userId int64) []int64 {
 	var ids []int64
 	err := repo.DB.Select(&ids, `SELECT
@@ -43,14 +81,26 @@ func (repo *Repository) GetUnreadSwipes(userId int64) []int64 {
         WHEN user_id1=$1 THEN user_id2
         WHEN user_id2=$1 THEN user_id1
     END FROM swipes 
-        WHERE (user_id1=$1 AND user_is_liked1 IS NULL) 
-           OR (user_id2=$1 AND user_is_liked2 IS NULL)`, userId)
+        WHERE (user_id1=$1 AND user_is_liked1 IS NULL AND user_is_liked2) 
+           OR (user_id2=$1 AND user_is_liked2 IS NULL AND user_is_liked1)
+        ORDER BY COALESCE(liked_at1, liked_at2) DESC`, userId)
 	if err != nil {
 		return nil
 	}
 	return ids
 }
 
+func (repo *Repository) CountUnreadSwipes(userId int64) (int64, error) {
+	var count int64
+	err := repo.DB.Get(&count, `SELECT count(*) FROM swipes 
+        WHERE (user_id1=$1 AND user_is_liked1 IS NULL AND user_is_liked2) 
+           OR (user_id2=$1 AND user_is_liked2 IS NULL AND user_is_liked1)`, userId)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (repo *Repository) GetSwipeById(userId1, userId2 int64) *models.Swipe {
 	var swipe models.Swipe
 	if userId1 > userId2 {
@@ -65,7 +115,346 @@ func (repo *Repository) GetSwipeById(userId1, userId2 int64) *models.Swipe {
 	return &swipe
 }
 
+func (repo *Repository) CreateMatch(userId1, userId2 int64) (*models.Match, error) {
+	var match models.Match
+	if userId1 > userId2 {
+		id1 := userId1
+		userId1 = userId2
+		userId2 = id1
+	}
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	err = tr.Get(&match, `INSERT INTO matches (user_id1, user_id2) VALUES ($1,$2)
+		ON CONFLICT (user_id1, user_id2) DO NOTHING
+		RETURNING id, user_id1, user_id2, created_at`, userId1, userId2)
+	if errors.Is(err, sql.ErrNoRows) {
+		tr.Rollback()
+		return nil, nil
+	}
+	if err != nil {
+		tr.Rollback()
+		return nil, err
+	}
+	err = events.Publish(tr, events.SwipesStream, events.Matched, events.MatchedPayload{
+		MatchId: match.Id,
+		UserId1: match.UserId1,
+		UserId2: match.UserId2,
+	})
+	if err != nil {
+		tr.Rollback()
+		return nil, err
+	}
+	err = tr.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return &match, nil
+}
+
+func (repo *Repository) GetMatches(userId int64) []models.Match {
+	var matches []models.Match
+	err := repo.DB.Select(&matches, `SELECT id, user_id1, user_id2, created_at FROM matches 
+		WHERE user_id1=$1 OR user_id2=$1
+		ORDER BY created_at DESC`, userId)
+	if err != nil {
+		return nil
+	}
+	return matches
+}
+
+func (repo *Repository) GetMatch(matchId int64) *models.Match {
+	var match models.Match
+	err := repo.DB.Get(&match, `SELECT id, user_id1, user_id2, created_at FROM matches WHERE id=$1`, matchId)
+	if err != nil {
+		return nil
+	}
+	return &match
+}
+
+func (repo *Repository) GetMatchByUsers(userId1, userId2 int64) *models.Match {
+	var match models.Match
+	if userId1 > userId2 {
+		id1 := userId1
+		userId1 = userId2
+		userId2 = id1
+	}
+	err := repo.DB.Get(&match, `SELECT id, user_id1, user_id2, created_at FROM matches WHERE user_id1=$1 AND user_id2=$2`, userId1, userId2)
+	if err != nil {
+		return nil
+	}
+	return &match
+}
+
+// Unmatch deletes the match and withdraws the pair's likes. The unmatch is recorded only if the
+// match still existed, a pair that never matched has nothing to record.
+func (repo *Repository) Unmatch(match *models.Match, initiatorId int64) error {
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	res, err := tr.Exec(`DELETE FROM matches WHERE id=$1`, match.Id)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	if deleted != 0 {
+		_, err = tr.Exec(`INSERT INTO unmatches (user_id1, user_id2, initiator_id) VALUES ($1,$2,$3)
+			ON CONFLICT (user_id1, user_id2) DO NOTHING`, match.UserId1, match.UserId2, initiatorId)
+		if err != nil {
+			tr.Rollback()
+			return err
+		}
+	}
+	_, err = tr.Exec(`UPDATE swipes SET user_is_liked1=false, user_is_liked2=false 
+		WHERE user_id1=$1 AND user_id2=$2`, match.UserId1, match.UserId2)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	return tr.Commit()
+}
+
+func (repo *Repository) IsUnmatched(userId1, userId2 int64) bool {
+	var exists bool
+	if userId1 > userId2 {
+		id1 := userId1
+		userId1 = userId2
+		userId2 = id1
+	}
+	err := repo.DB.Get(&exists, `SELECT EXISTS(SELECT 1 FROM unmatches WHERE user_id1=$1 AND user_id2=$2)`, userId1, userId2)
+	if err != nil {
+		return false
+	}
+	return exists
+}
+
+func (repo *Repository) GetLastSwipeEvent(swiperId int64) *models.SwipeEvent {
+	var event models.SwipeEvent
+	err := repo.DB.Get(&event, `SELECT * FROM swipe_history 
+		WHERE swiper_id=$1 AND rewound_at IS NULL
+		ORDER BY id DESC
+		LIMIT 1`, swiperId)
+	if err != nil {
+		return nil
+	}
+	return &event
+}
+
+func (repo *Repository) HasMatch(userId1, userId2 int64) bool {
+	var exists bool
+	if userId1 > userId2 {
+		id1 := userId1
+		userId1 = userId2
+		userId2 = id1
+	}
+	err := repo.DB.Get(&exists, `SELECT EXISTS(SELECT 1 FROM matches WHERE user_id1=$1 AND user_id2=$2)
+		OR EXISTS(SELECT 1 FROM unmatches WHERE user_id1=$1 AND user_id2=$2)`, userId1, userId2)
+	if err != nil {
+		return true
+	}
+	return exists
+}
+
+// Rewind restores the swiper's side of the pair to the state it had before
+// event and marks the event as rewound.
+func (repo *Repository) Rewind(event *models.SwipeEvent) error {
+	userId1, userId2 := event.SwiperId, event.TargetId
+	likedColumn, likedAtColumn, kindColumn := "user_is_liked1", "liked_at1", "kind1"
+	if userId1 > userId2 {
+		userId1, userId2 = userId2, userId1
+		likedColumn, likedAtColumn, kindColumn = "user_is_liked2", "liked_at2", "kind2"
+	}
+	tr, err := repo.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	var prev models.SwipeEvent
+	var prevIsLike *bool
+	var prevKind *models.SwipeKind
+	var prevCreatedAt *time.Time
+	err = tr.Get(&prev, `SELECT * FROM swipe_history
+		WHERE swiper_id=$1 AND target_id=$2 AND rewound_at IS NULL AND id < $3
+		ORDER BY id DESC
+		LIMIT 1`, event.SwiperId, event.TargetId, event.Id)
+	if err == nil {
+		prevIsLike = &prev.IsLike
+		prevKind = &prev.Kind
+		prevCreatedAt = &prev.CreatedAt
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		tr.Rollback()
+		return err
+	}
+	_, err = tr.Exec(fmt.Sprintf(`UPDATE swipes SET %s=$3, %s=CASE WHEN $3 THEN $4::timestamptz END, %s=$5, updated_at=now()
+		WHERE user_id1=$1 AND user_id2=$2`, likedColumn, likedAtColumn, kindColumn),
+		userId1, userId2, prevIsLike, prevCreatedAt, prevKind)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	_, err = tr.Exec(`DELETE FROM swipes 
+		WHERE user_id1=$1 AND user_id2=$2 AND user_is_liked1 IS NULL AND user_is_liked2 IS NULL`, userId1, userId2)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	_, err = tr.Exec(fmt.Sprintf(`UPDATE swipes SET first_swiper_id=$3
+		WHERE user_id1=$1 AND user_id2=$2 AND first_swiper_id=$4 AND %s IS NULL`, likedColumn),
+		userId1, userId2, event.TargetId, event.SwiperId)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	_, err = tr.Exec(`UPDATE swipe_history SET rewound_at=now() WHERE id=$1`, event.Id)
+	if err != nil {
+		tr.Rollback()
+		return err
+	}
+	return tr.Commit()
+}
+
+func (repo *Repository) IncrSuperLikes(userId int64, day time.Time) (int64, error) {
+	ctx := context.Background()
+	key := superLikesKey(userId, day)
+	count, err := repo.Redis.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		repo.Redis.Expire(ctx, key, 48*time.Hour)
+	}
+	return count, nil
+}
+
+func (repo *Repository) DecrSuperLikes(userId int64, day time.Time) error {
+	return repo.Redis.Decr(context.Background(), superLikesKey(userId, day)).Err()
+}
+
+func superLikesKey(userId int64, day time.Time) string {
+	return fmt.Sprintf("user:%d:super_likes:%s", userId, day.UTC().Format("2006-01-02"))
+}
+
+func (repo *Repository) GetSuperLikes(userId int64, day time.Time) (int64, error) {
+	count, err := repo.Redis.Get(context.Background(), superLikesKey(userId, day)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return count, err
+}
+
+// ReserveLike records a like for targetId in the user's rolling window. It returns the number of likes
+// in the window, the time of the oldest of them and whether the like was not counted before.
+func (repo *Repository) ReserveLike(userId, targetId int64, now time.Time, window time.Duration) (int64, time.Time, bool, error) {
+	ctx := context.Background()
+	key := likesKey(userId)
+	var added *redis.IntCmd
+	var count *redis.IntCmd
+	var oldest *redis.ZSliceCmd
+	_, err := repo.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixNano(), 10))
+		added = pipe.ZAddNX(ctx, key, &redis.Z{Score: float64(now.UnixNano()), Member: targetId})
+		count = pipe.ZCard(ctx, key)
+		oldest = pipe.ZRangeWithScores(ctx, key, 0, 0)
+		pipe.Expire(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, time.Time{}, false, err
+	}
+	return count.Val(), oldestLike(oldest.Val(), now), added.Val() == 1, nil
+}
+
+func (repo *Repository) ReleaseLike(userId, targetId int64) error {
+	return repo.Redis.ZRem(context.Background(), likesKey(userId), targetId).Err()
+}
+
+func (repo *Repository) GetLikesInWindow(userId int64, now time.Time, window time.Duration) (int64, time.Time, error) {
+	ctx := context.Background()
+	key := likesKey(userId)
+	var count *redis.IntCmd
+	var oldest *redis.ZSliceCmd
+	_, err := repo.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixNano(), 10))
+		count = pipe.ZCard(ctx, key)
+		oldest = pipe.ZRangeWithScores(ctx, key, 0, 0)
+		return nil
+	})
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return count.Val(), oldestLike(oldest.Val(), now), nil
+}
+
+// CountLikesSince counts likes from the swipe history and is used when Redis is unavailable.
+func (repo *Repository) CountLikesSince(userId int64, since time.Time) (int64, time.Time, error) {
+	var res struct {
+		Count  int64      `db:"count"`
+		Oldest *time.Time `db:"oldest"`
+	}
+	err := repo.DB.Get(&res, `SELECT count(DISTINCT target_id) AS count, min(created_at) AS oldest FROM swipe_history
+		WHERE swiper_id=$1 AND is_like AND rewound_at IS NULL AND created_at > $2`, userId, since)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if res.Oldest == nil {
+		return res.Count, time.Now(), nil
+	}
+	return res.Count, *res.Oldest, nil
+}
+
+// TrimLikes drops likes older than before from every like window. Windows are trimmed on every
+// like as well, this frees the ones of users who stopped swiping before their key expired.
+func (repo *Repository) TrimLikes(ctx context.Context, before time.Time) (int64, error) {
+	var removed int64
+	max := strconv.FormatInt(before.UnixNano(), 10)
+	iter := repo.Redis.Scan(ctx, 0, "user:*:likes", 1000).Iterator()
+	for iter.Next(ctx) {
+		n, err := repo.Redis.ZRemRangeByScore(ctx, iter.Val(), "-inf", max).Result()
+		if err != nil {
+			return removed, err
+		}
+		removed += n
+	}
+	return removed, iter.Err()
+}
+
+// PurgeOutbox deletes events published before the given time.
+func (repo *Repository) PurgeOutbox(before time.Time) (int64, error) {
+	return events.Purge(repo.DB, before)
+}
+
+func likesKey(userId int64) string {
+	return fmt.Sprintf("user:%d:likes", userId)
+}
+
+func oldestLike(oldest []redis.Z, now time.Time) time.Time {
+	if len(oldest) == 0 {
+		return now
+	}
+	return time.Unix(0, int64(oldest[0].Score))
+}
+
+// AddSwipeToRedis puts a rewound candidate back on top of the ranked pool.
+func (repo *Repository) AddSwipeToRedis(candidateListKey string, userId int64) error {
+	ctx := context.Background()
+	top, err := repo.Redis.ZRevRangeWithScores(ctx, candidateListKey, 0, 0).Result()
+	if err != nil {
+		return err
+	}
+	var score float64
+	if len(top) > 0 {
+		score = top[0].Score + 1
+	}
+	return repo.Redis.ZAdd(ctx, candidateListKey, &redis.Z{Score: score, Member: userId}).Err()
+}
+
 func (repo *Repository) RemoveSwipeFromRedis(candidateListKey string, userId int64) error {
-	err := repo.Redis.SRem(context.Background(), candidateListKey, userId).Err()
+	err := repo.Redis.ZRem(context.Background(), candidateListKey, userId).Err()
 	return err
 }
@@ -1,37 +1,99 @@
 package swipes
 
 import (
+	"context"
+	"flame/internal/config"
 	"flame/internal/interfaces"
+	"flame/internal/models"
+	http_errors "flame/pkg/errors"
+	"flame/pkg/pb"
 	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"log/slog"
 	"net/http"
+	"time"
+)
+
+const (
+	defaultRewindWindow     = 5 * time.Minute
+	defaultSuperLikesPerDay = 1
+	defaultLikesPerDay      = 100
+	likesWindow             = 24 * time.Hour
 )
 
 type ServiceDeps struct {
-	Repository interfaces.SwipesRepository
-	Logger     *slog.Logger
+	Repository    interfaces.SwipesRepository
+	Logger        *slog.Logger
+	Config        *config.Config
+	AccountClient pb.AccountClient
 }
 type Service struct {
-	Logger     *slog.Logger
-	Repository interfaces.SwipesRepository
+	Logger        *slog.Logger
+	Repository    interfaces.SwipesRepository
+	Config        *config.Config
+	AccountClient pb.AccountClient
 }
 
 func NewService(deps *ServiceDeps) *Service {
 	return &Service{
-		Logger:     deps.Logger,
-		Repository: deps.Repository,
+		Logger:        deps.Logger,
+		Repository:    deps.Repository,
+		Config:        deps.Config,
+		AccountClient: deps.AccountClient,
 	}
 }
 
-func (service *Service) CreateOrUpdate(userId1, userId2 int64, isLike bool) error {
+func (service *Service) CreateOrUpdate(userId1, userId2 int64, kind models.SwipeKind) (*models.Match, error) {
 	if userId1 == userId2 {
-		return status.Errorf(codes.InvalidArgument, http.StatusText(http.StatusBadRequest))
+		return nil, status.Errorf(codes.InvalidArgument, http.StatusText(http.StatusBadRequest))
 	}
-	err := service.Repository.CreateOrUpdate(userId1, userId2, isLike)
+	if !kind.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, http_errors.InvalidSwipeKind)
+	}
+	blocked, err := service.AccountClient.IsBlocked(context.Background(), &pb.IsBlockedReq{
+		UserId1: userId1,
+		UserId2: userId2,
+	})
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, http.StatusText(http.StatusBadRequest))
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.AccountClient.IsBlocked"),
+			slog.Int64("UserId1", userId1),
+			slog.Int64("UserId2", userId2),
+		)
+		return nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	if blocked.IsBlocked {
+		return nil, status.Errorf(codes.PermissionDenied, http_errors.UserBlocked)
+	}
+	now := time.Now()
+	// reserved is set only if this like took a place in the window, a repeat like of the same
+	// target or a quota checked without Redis must not give back the place of an earlier like.
+	reserved := false
+	if kind.IsLike() {
+		reserved, err = service.useLike(userId1, userId2, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if kind == models.SwipeSuperLike {
+		err := service.useSuperLike(userId1, now)
+		if err != nil {
+			if reserved {
+				service.releaseLike(userId1, userId2)
+			}
+			return nil, err
+		}
+	}
+	err = service.Repository.CreateOrUpdate(userId1, userId2, kind)
+	if err != nil {
+		if reserved {
+			service.releaseLike(userId1, userId2)
+		}
+		if kind == models.SwipeSuperLike {
+			service.refundSuperLike(userId1, now)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, http.StatusText(http.StatusBadRequest))
 	}
 	candidateListKey := fmt.Sprintf("user:%d:candidates", userId1)
 	err = service.Repository.RemoveSwipeFromRedis(candidateListKey, userId2)
@@ -42,10 +104,299 @@ func (service *Service) CreateOrUpdate(userId1, userId2 int64, isLike bool) erro
 			slog.Int64("UserId2", userId2),
 		)
 	}
-	return nil
+	if !kind.IsLike() {
+		return nil, nil
+	}
+	swipe := service.Repository.GetSwipeById(userId1, userId2)
+	if swipe == nil || !swipe.IsMutual() {
+		return nil, nil
+	}
+	if service.Repository.IsUnmatched(userId1, userId2) {
+		return nil, nil
+	}
+	match, err := service.Repository.CreateMatch(userId1, userId2)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.CreateMatch"),
+			slog.Int64("UserId1", userId1),
+			slog.Int64("UserId2", userId2),
+		)
+		return nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	return match, nil
 }
 
 func (service *Service) GetUnreadSwipes(userId int64) []int64 {
 	userIds := service.Repository.GetUnreadSwipes(userId)
 	return userIds
 }
+
+func (service *Service) CountUnreadSwipes(userId int64) (int64, error) {
+	count, err := service.Repository.CountUnreadSwipes(userId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.CountUnreadSwipes"),
+			slog.Int64("UserId", userId),
+		)
+		return 0, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	return count, nil
+}
+
+func (service *Service) GetMatches(userId int64) []models.Match {
+	return service.Repository.GetMatches(userId)
+}
+
+func (service *Service) GetMatch(userId, matchId int64) (*models.Match, error) {
+	match := service.Repository.GetMatch(matchId)
+	if match == nil {
+		return nil, status.Errorf(codes.NotFound, http_errors.MatchNotFound)
+	}
+	if !match.Has(userId) {
+		return nil, status.Errorf(codes.PermissionDenied, http.StatusText(http.StatusForbidden))
+	}
+	return match, nil
+}
+
+func (service *Service) Unmatch(userId, matchId int64) (int64, error) {
+	match, err := service.GetMatch(userId, matchId)
+	if err != nil {
+		return -1, err
+	}
+	err = service.Repository.Unmatch(match, userId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.Unmatch"),
+			slog.Int64("MatchId", matchId),
+			slog.Int64("UserId", userId),
+		)
+		return -1, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	for _, pair := range [][2]int64{{match.UserId1, match.UserId2}, {match.UserId2, match.UserId1}} {
+		candidateListKey := fmt.Sprintf("user:%d:candidates", pair[0])
+		err = service.Repository.RemoveSwipeFromRedis(candidateListKey, pair[1])
+		if err != nil {
+			service.Logger.Error(err.Error(),
+				slog.String("Error location", "service.Repository.RemoveSwipeFromRedis"),
+				slog.String("CandidateListKey", candidateListKey),
+				slog.Int64("UserId", pair[1]),
+			)
+		}
+	}
+	return match.Partner(userId), nil
+}
+
+// BlockPair ends the pair's match if there is one and withdraws both users' likes, so the pair
+// no longer shows up in matches, likes and candidate feeds. It returns the ended match or nil.
+func (service *Service) BlockPair(userId, targetId int64) (*models.Match, error) {
+	match := service.Repository.GetMatchByUsers(userId, targetId)
+	pair := match
+	if pair == nil {
+		pair = &models.Match{UserId1: userId, UserId2: targetId}
+		if userId > targetId {
+			pair.UserId1, pair.UserId2 = targetId, userId
+		}
+	}
+	err := service.Repository.Unmatch(pair, userId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.Unmatch"),
+			slog.Int64("UserId", userId),
+			slog.Int64("TargetId", targetId),
+		)
+		return nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	for _, p := range [][2]int64{{userId, targetId}, {targetId, userId}} {
+		candidateListKey := fmt.Sprintf("user:%d:candidates", p[0])
+		err = service.Repository.RemoveSwipeFromRedis(candidateListKey, p[1])
+		if err != nil {
+			service.Logger.Error(err.Error(),
+				slog.String("Error location", "service.Repository.RemoveSwipeFromRedis"),
+				slog.String("CandidateListKey", candidateListKey),
+				slog.Int64("UserId", p[1]),
+			)
+		}
+	}
+	return match, nil
+}
+
+func (service *Service) IsMatched(userId1, userId2 int64) bool {
+	swipe := service.Repository.GetSwipeById(userId1, userId2)
+	if swipe == nil || !swipe.IsMutual() {
+		return false
+	}
+	return !service.Repository.IsUnmatched(userId1, userId2)
+}
+
+func (service *Service) Rewind(userId int64) (int64, error) {
+	event := service.Repository.GetLastSwipeEvent(userId)
+	if event == nil {
+		return -1, status.Errorf(codes.NotFound, http_errors.NothingToRewind)
+	}
+	window := service.Config.Swipes.RewindWindow
+	if window <= 0 {
+		window = defaultRewindWindow
+	}
+	if time.Since(event.CreatedAt) > window {
+		return -1, status.Errorf(codes.FailedPrecondition, http_errors.RewindWindowExpired)
+	}
+	if service.Repository.HasMatch(event.SwiperId, event.TargetId) {
+		return -1, status.Errorf(codes.FailedPrecondition, http_errors.RewindMatched)
+	}
+	err := service.Repository.Rewind(event)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.Rewind"),
+			slog.Int64("UserId", userId),
+			slog.Int64("EventId", event.Id),
+		)
+		return -1, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	if event.IsLike {
+		service.releaseLike(userId, event.TargetId)
+	}
+	if event.Kind == models.SwipeSuperLike {
+		service.refundSuperLike(userId, event.CreatedAt)
+	}
+	candidateListKey := fmt.Sprintf("user:%d:candidates", userId)
+	err = service.Repository.AddSwipeToRedis(candidateListKey, event.TargetId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.AddSwipeToRedis"),
+			slog.String("CandidateListKey", candidateListKey),
+			slog.Int64("TargetId", event.TargetId),
+		)
+	}
+	return event.TargetId, nil
+}
+
+func (service *Service) useSuperLike(userId int64, now time.Time) error {
+	limit := service.Config.Swipes.SuperLikesPerDay
+	if limit <= 0 {
+		limit = defaultSuperLikesPerDay
+	}
+	count, err := service.Repository.IncrSuperLikes(userId, now)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.IncrSuperLikes"),
+			slog.Int64("UserId", userId),
+		)
+		return status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	if count > int64(limit) {
+		service.refundSuperLike(userId, now)
+		return http_errors.QuotaExceeded(http_errors.SuperLikesExhausted, nextDay(now))
+	}
+	return nil
+}
+
+func (service *Service) refundSuperLike(userId int64, day time.Time) {
+	err := service.Repository.DecrSuperLikes(userId, day)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.DecrSuperLikes"),
+			slog.Int64("UserId", userId),
+		)
+	}
+}
+
+// useLike counts a like towards the rolling daily quota and reports whether it reserved a place
+// for targetId that the caller must release if the swipe fails. When Redis is unavailable the
+// quota is checked against the swipe history instead and nothing is reserved.
+func (service *Service) useLike(userId, targetId int64, now time.Time) (bool, error) {
+	limit := service.likesPerDay()
+	count, oldest, added, err := service.Repository.ReserveLike(userId, targetId, now, likesWindow)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.ReserveLike"),
+			slog.Int64("UserId", userId),
+		)
+		count, oldest, err = service.Repository.CountLikesSince(userId, now.Add(-likesWindow))
+		if err != nil {
+			service.Logger.Error(err.Error(),
+				slog.String("Error location", "service.Repository.CountLikesSince"),
+				slog.Int64("UserId", userId),
+			)
+			return false, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+		}
+		if count >= limit {
+			return false, http_errors.QuotaExceeded(http_errors.LikesExhausted, oldest.Add(likesWindow))
+		}
+		return false, nil
+	}
+	if added && count > limit {
+		service.releaseLike(userId, targetId)
+		return false, http_errors.QuotaExceeded(http_errors.LikesExhausted, oldest.Add(likesWindow))
+	}
+	return added, nil
+}
+
+func (service *Service) releaseLike(userId, targetId int64) {
+	err := service.Repository.ReleaseLike(userId, targetId)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.ReleaseLike"),
+			slog.Int64("UserId", userId),
+			slog.Int64("TargetId", targetId),
+		)
+	}
+}
+
+func (service *Service) GetQuota(userId int64) (*models.Quota, *models.Quota, error) {
+	now := time.Now()
+	likes := &models.Quota{Limit: service.likesPerDay()}
+	count, oldest, err := service.Repository.GetLikesInWindow(userId, now, likesWindow)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetLikesInWindow"),
+			slog.Int64("UserId", userId),
+		)
+		count, oldest, err = service.Repository.CountLikesSince(userId, now.Add(-likesWindow))
+		if err != nil {
+			service.Logger.Error(err.Error(),
+				slog.String("Error location", "service.Repository.CountLikesSince"),
+				slog.Int64("UserId", userId),
+			)
+			return nil, nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+		}
+	}
+	likes.Used = count
+	if count > 0 {
+		resetAt := oldest.Add(likesWindow)
+		likes.ResetAt = &resetAt
+	}
+
+	superLimit := service.Config.Swipes.SuperLikesPerDay
+	if superLimit <= 0 {
+		superLimit = defaultSuperLikesPerDay
+	}
+	superLikesUsed, err := service.Repository.GetSuperLikes(userId, now)
+	if err != nil {
+		service.Logger.Error(err.Error(),
+			slog.String("Error location", "service.Repository.GetSuperLikes"),
+			slog.Int64("UserId", userId),
+		)
+		return nil, nil, status.Errorf(codes.Internal, http.StatusText(http.StatusInternalServerError))
+	}
+	superResetAt := nextDay(now)
+	superLikes := &models.Quota{
+		Limit:   int64(superLimit),
+		Used:    superLikesUsed,
+		ResetAt: &superResetAt,
+	}
+	return likes, superLikes, nil
+}
+
+func (service *Service) likesPerDay() int64 {
+	limit := service.Config.Swipes.LikesPerDay
+	if limit <= 0 {
+		limit = defaultLikesPerDay
+	}
+	return int64(limit)
+}
+
+// nextDay returns the UTC midnight after t, when the daily super like counter starts over.
+func nextDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,107 @@
+package swipes
+
+import (
+	"context"
+	"errors"
+	"flame/internal/config"
+	"flame/internal/models"
+	"flame/pkg/logger"
+	"flame/pkg/pb"
+	"flame/tests/mocks"
+	"github.com/go-playground/assert/v2"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"os"
+	"testing"
+	"time"
+)
+
+type notBlockedClient struct {
+	pb.AccountClient
+}
+
+func (client *notBlockedClient) IsBlocked(ctx context.Context, in *pb.IsBlockedReq, opts ...grpc.CallOption) (*pb.IsBlockedRes, error) {
+	return &pb.IsBlockedRes{IsBlocked: false}, nil
+}
+
+func TestService_CreateOrUpdate_ReleaseLike(t *testing.T) {
+	repo := new(mocks.MockSwipesRepository)
+	service := NewService(&ServiceDeps{
+		Logger:        logger.NewLogger(os.Stdout),
+		Repository:    repo,
+		Config:        &config.Config{},
+		AccountClient: &notBlockedClient{},
+	})
+	tests := []struct {
+		name    string
+		kind    models.SwipeKind
+		repo    func()
+		release bool
+	}{
+		{
+			name: "like reserved then failed",
+			kind: models.SwipeLike,
+			repo: func() {
+				repo.On("ReserveLike", int64(1), int64(2), mock.Anything, likesWindow).Return(1, time.Now(), true, nil)
+				repo.On("CreateOrUpdate", int64(1), int64(2), models.SwipeLike).Return(errors.New(""))
+				repo.On("ReleaseLike", int64(1), int64(2)).Return(nil)
+			},
+			release: true,
+		},
+		{
+			name: "repeat like failed",
+			kind: models.SwipeLike,
+			repo: func() {
+				repo.On("ReserveLike", int64(1), int64(2), mock.Anything, likesWindow).Return(1, time.Now(), false, nil)
+				repo.On("CreateOrUpdate", int64(1), int64(2), models.SwipeLike).Return(errors.New(""))
+			},
+			release: false,
+		},
+		{
+			name: "like checked without redis then failed",
+			kind: models.SwipeLike,
+			repo: func() {
+				repo.On("ReserveLike", int64(1), int64(2), mock.Anything, likesWindow).Return(0, time.Time{}, false, errors.New(""))
+				repo.On("CountLikesSince", int64(1), mock.Anything).Return(1, time.Now(), nil)
+				repo.On("CreateOrUpdate", int64(1), int64(2), models.SwipeLike).Return(errors.New(""))
+			},
+			release: false,
+		},
+		{
+			name: "super like reserved then out of super likes",
+			kind: models.SwipeSuperLike,
+			repo: func() {
+				repo.On("ReserveLike", int64(1), int64(2), mock.Anything, likesWindow).Return(1, time.Now(), true, nil)
+				repo.On("IncrSuperLikes", int64(1), mock.Anything).Return(defaultSuperLikesPerDay+1, nil)
+				repo.On("DecrSuperLikes", int64(1), mock.Anything).Return(nil)
+				repo.On("ReleaseLike", int64(1), int64(2)).Return(nil)
+			},
+			release: true,
+		},
+		{
+			name: "repeat super like out of super likes",
+			kind: models.SwipeSuperLike,
+			repo: func() {
+				repo.On("ReserveLike", int64(1), int64(2), mock.Anything, likesWindow).Return(1, time.Now(), false, nil)
+				repo.On("IncrSuperLikes", int64(1), mock.Anything).Return(defaultSuperLikesPerDay+1, nil)
+				repo.On("DecrSuperLikes", int64(1), mock.Anything).Return(nil)
+			},
+			release: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.repo()
+			t.Cleanup(func() {
+				repo.ExpectedCalls = nil
+				repo.Calls = nil
+			})
+			_, err := service.CreateOrUpdate(1, 2, tt.kind)
+			assert.NotEqual(t, err, nil)
+			repo.AssertExpectations(t)
+			if !tt.release {
+				repo.AssertNotCalled(t, "ReleaseLike", int64(1), int64(2))
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE blocks(
+    blocker_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    blocked_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    PRIMARY KEY (blocker_id, blocked_id)
+);
+CREATE INDEX idx_blocks_blocked_id ON blocks(blocked_id);
+
+CREATE TYPE report_reason AS ENUM ('spam', 'fake', 'inappropriate', 'harassment', 'underage', 'other');
+CREATE TABLE reports(
+    id BIGSERIAL PRIMARY KEY,
+    reporter_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    reported_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    reason report_reason NOT NULL,
+    comment TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now()
+);
+CREATE INDEX idx_reports_reported_id ON reports(reported_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE reports;
+DROP TYPE report_reason;
+DROP TABLE blocks;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE user_role AS ENUM ('user', 'admin');
+ALTER TABLE users ADD COLUMN role user_role NOT NULL DEFAULT 'user';
+
+CREATE TYPE report_status AS ENUM ('open', 'resolved', 'dismissed');
+ALTER TABLE reports ADD COLUMN status report_status NOT NULL DEFAULT 'open';
+ALTER TABLE reports ADD COLUMN resolved_by BIGINT REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE reports ADD COLUMN resolved_at TIMESTAMP WITH TIME ZONE;
+CREATE INDEX idx_reports_open ON reports(id) WHERE status = 'open';
+
+CREATE TYPE sanction_kind AS ENUM ('suspend', 'ban');
+CREATE TABLE sanctions(
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    admin_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    kind sanction_kind NOT NULL,
+    reason TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now()
+);
+CREATE INDEX idx_sanctions_user_id ON sanctions(user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sanctions;
+DROP TYPE sanction_kind;
+DROP INDEX idx_reports_open;
+ALTER TABLE reports DROP COLUMN resolved_at;
+ALTER TABLE reports DROP COLUMN resolved_by;
+ALTER TABLE reports DROP COLUMN status;
+DROP TYPE report_status;
+ALTER TABLE users DROP COLUMN role;
+DROP TYPE user_role;
+-- +goose StatementEnd
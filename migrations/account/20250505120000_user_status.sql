@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE user_status AS ENUM ('active', 'suspended', 'banned');
+ALTER TABLE users ADD COLUMN status user_status NOT NULL DEFAULT 'active';
+ALTER TABLE users ADD COLUMN suspended_until TIMESTAMP WITH TIME ZONE;
+UPDATE users u SET status = 'banned'
+    WHERE EXISTS (SELECT 1 FROM sanctions s WHERE s.user_id = u.id AND s.kind = 'ban');
+UPDATE users u SET status = 'suspended', suspended_until = s.expires_at
+    FROM (SELECT user_id, max(expires_at) AS expires_at FROM sanctions WHERE kind = 'suspend' GROUP BY user_id) s
+    WHERE s.user_id = u.id AND u.status = 'active' AND s.expires_at > now();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN suspended_until;
+ALTER TABLE users DROP COLUMN status;
+DROP TYPE user_status;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE preferences
+    ADD COLUMN age_min INT CHECK ( age_min >= 16 AND age_min <= 110 ),
+    ADD COLUMN age_max INT CHECK ( age_max >= 16 AND age_max <= 110 ),
+    ADD CONSTRAINT preferences_age_range CHECK ( age_min IS NULL OR age_max IS NULL OR age_min <= age_max );
+UPDATE preferences SET
+    age_min = LEAST(GREATEST(ROUND(age * 0.8), 16), 110),
+    age_max = LEAST(GREATEST(ROUND(age * 1.2), 20), 110)
+WHERE age IS NOT NULL;
+ALTER TABLE preferences DROP COLUMN age;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE preferences ADD COLUMN age INT;
+UPDATE preferences SET age = ROUND((COALESCE(age_min, age_max) + COALESCE(age_max, age_min)) / 2.0)
+WHERE age_min IS NOT NULL OR age_max IS NOT NULL;
+ALTER TABLE preferences DROP CONSTRAINT preferences_age_range;
+ALTER TABLE preferences DROP COLUMN age_min, DROP COLUMN age_max;
+-- +goose StatementEnd
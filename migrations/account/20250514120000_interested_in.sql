@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE user_gender ADD VALUE IF NOT EXISTS 'non_binary';
+ALTER TYPE user_gender ADD VALUE IF NOT EXISTS 'genderqueer';
+ALTER TYPE user_gender ADD VALUE IF NOT EXISTS 'genderfluid';
+ALTER TYPE user_gender ADD VALUE IF NOT EXISTS 'agender';
+ALTER TYPE user_gender ADD VALUE IF NOT EXISTS 'other';
+
+CREATE TABLE interested_in(
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    gender user_gender NOT NULL,
+    PRIMARY KEY (user_id, gender)
+);
+
+INSERT INTO interested_in (user_id, gender)
+SELECT user_id, gender FROM preferences WHERE gender IS NOT NULL;
+
+ALTER TABLE preferences DROP COLUMN gender;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE preferences ADD COLUMN gender TEXT;
+UPDATE preferences p SET gender = i.gender::text
+FROM (SELECT user_id, MIN(gender::text) AS gender FROM interested_in GROUP BY user_id HAVING COUNT(*) = 1) i
+WHERE p.user_id = i.user_id AND i.gender IN ('male', 'female');
+DROP TABLE interested_in;
+
+UPDATE users SET gender = NULL WHERE gender::text NOT IN ('male', 'female');
+ALTER TYPE user_gender RENAME TO user_gender_old;
+CREATE TYPE user_gender AS ENUM ('male', 'female');
+ALTER TABLE users ALTER COLUMN gender TYPE user_gender USING gender::text::user_gender;
+ALTER TABLE preferences ALTER COLUMN gender TYPE user_gender USING gender::user_gender;
+DROP TYPE user_gender_old;
+-- +goose StatementEnd
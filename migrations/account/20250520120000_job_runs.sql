@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE job_runs(
+    id BIGSERIAL PRIMARY KEY,
+    job TEXT NOT NULL,
+    instance TEXT NOT NULL,
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts INT NOT NULL,
+    status TEXT NOT NULL,
+    error TEXT
+);
+CREATE INDEX job_runs_job_idx ON job_runs (job, scheduled_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE job_runs;
+-- +goose StatementEnd
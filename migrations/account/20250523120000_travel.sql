@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN travel_location geography(Point, 4326),
+    ADD COLUMN travel_starts_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN travel_ends_at TIMESTAMP WITH TIME ZONE,
+    ADD CONSTRAINT users_travel_check CHECK (
+        (travel_location IS NULL AND travel_starts_at IS NULL AND travel_ends_at IS NULL) OR
+        (travel_location IS NOT NULL AND travel_starts_at < travel_ends_at)
+    );
+
+CREATE FUNCTION is_travelling(u users) RETURNS boolean AS $$
+    SELECT u.travel_location IS NOT NULL AND u.travel_starts_at <= now() AND now() < u.travel_ends_at
+$$ LANGUAGE sql STABLE;
+
+-- discovery_location is the point a user is matched from and shown at: the travel location while
+-- the trip lasts, the real location otherwise.
+CREATE FUNCTION discovery_location(u users) RETURNS geography AS $$
+    SELECT CASE WHEN is_travelling(u) THEN u.travel_location ELSE u.location END
+$$ LANGUAGE sql STABLE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION discovery_location(users);
+DROP FUNCTION is_travelling(users);
+ALTER TABLE users
+    DROP CONSTRAINT users_travel_check,
+    DROP COLUMN travel_location,
+    DROP COLUMN travel_starts_at,
+    DROP COLUMN travel_ends_at;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Locations are stored snapped to a 0.01 degree grid, the same step as geo.Grid.
+UPDATE users SET location = ST_SnapToGrid(location::geometry, 0.01)::geography WHERE location IS NOT NULL;
+UPDATE users SET travel_location = ST_SnapToGrid(travel_location::geometry, 0.01)::geography WHERE travel_location IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The exact locations are gone, there is nothing to restore.
+SELECT 1;
+-- +goose StatementEnd
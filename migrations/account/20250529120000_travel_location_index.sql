@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Candidates are searched on location or travel_location depending on whether they travel, both
+-- need an index for st_dwithin.
+CREATE INDEX idx_users_travel_location ON users USING GIST (travel_location);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_users_travel_location;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE conversations(
+    id BIGSERIAL PRIMARY KEY,
+    user_id1 BIGINT NOT NULL,
+    user_id2 BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    UNIQUE (user_id1, user_id2)
+);
+CREATE INDEX idx_conversations_user_id1 ON conversations(user_id1);
+CREATE INDEX idx_conversations_user_id2 ON conversations(user_id2);
+
+CREATE TABLE messages(
+    id BIGSERIAL PRIMARY KEY,
+    conversation_id BIGINT NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+    sender_id BIGINT NOT NULL,
+    text TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    read_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_messages_conversation_id ON messages(conversation_id, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE messages;
+DROP TABLE conversations;
+-- +goose StatementEnd
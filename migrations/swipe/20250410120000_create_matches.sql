@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE matches(
+    id BIGSERIAL PRIMARY KEY,
+    user_id1 BIGINT NOT NULL,
+    user_id2 BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    UNIQUE (user_id1, user_id2)
+);
+CREATE INDEX idx_matches_user_id1 ON matches(user_id1);
+CREATE INDEX idx_matches_user_id2 ON matches(user_id2);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE matches;
+-- +goose StatementEnd
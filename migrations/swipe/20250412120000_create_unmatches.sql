@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE unmatches(
+    user_id1 BIGINT NOT NULL,
+    user_id2 BIGINT NOT NULL,
+    initiator_id BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    PRIMARY KEY (user_id1, user_id2)
+);
+CREATE INDEX idx_unmatches_user_id2 ON unmatches(user_id2);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE unmatches;
+-- +goose StatementEnd
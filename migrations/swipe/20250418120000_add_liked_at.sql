@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE swipes ADD COLUMN liked_at1 TIMESTAMP WITH TIME ZONE;
+ALTER TABLE swipes ADD COLUMN liked_at2 TIMESTAMP WITH TIME ZONE;
+UPDATE swipes SET liked_at1 = now() WHERE user_is_liked1;
+UPDATE swipes SET liked_at2 = now() WHERE user_is_liked2;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE swipes DROP COLUMN liked_at1;
+ALTER TABLE swipes DROP COLUMN liked_at2;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE swipes ADD COLUMN first_swiper_id BIGINT;
+ALTER TABLE swipes ADD COLUMN first_swiped_at TIMESTAMP WITH TIME ZONE;
+UPDATE swipes SET first_swiper_id = user_id1, first_swiped_at = now()
+    WHERE user_is_liked1 IS NOT NULL AND user_is_liked2 IS NULL;
+UPDATE swipes SET first_swiper_id = user_id2, first_swiped_at = now()
+    WHERE user_is_liked2 IS NOT NULL AND user_is_liked1 IS NULL;
+CREATE INDEX idx_swipes_pending_likes1 ON swipes(user_id1) WHERE user_is_liked1 IS NULL AND user_is_liked2;
+CREATE INDEX idx_swipes_pending_likes2 ON swipes(user_id2) WHERE user_is_liked2 IS NULL AND user_is_liked1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_swipes_pending_likes1;
+DROP INDEX idx_swipes_pending_likes2;
+ALTER TABLE swipes DROP COLUMN first_swiper_id;
+ALTER TABLE swipes DROP COLUMN first_swiped_at;
+-- +goose StatementEnd
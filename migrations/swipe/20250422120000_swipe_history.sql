@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE swipes ADD COLUMN created_at TIMESTAMP WITH TIME ZONE DEFAULT now();
+ALTER TABLE swipes ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE DEFAULT now();
+
+CREATE TABLE swipe_history(
+    id BIGSERIAL PRIMARY KEY,
+    swiper_id BIGINT NOT NULL,
+    target_id BIGINT NOT NULL,
+    is_like bool NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT now(),
+    rewound_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX idx_swipe_history_swiper_id ON swipe_history(swiper_id, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE swipe_history;
+ALTER TABLE swipes DROP COLUMN created_at;
+ALTER TABLE swipes DROP COLUMN updated_at;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE swipe_kind AS ENUM ('dislike', 'like', 'super_like');
+ALTER TABLE swipes ADD COLUMN kind1 swipe_kind;
+ALTER TABLE swipes ADD COLUMN kind2 swipe_kind;
+UPDATE swipes SET kind1 = CASE WHEN user_is_liked1 THEN 'like'::swipe_kind ELSE 'dislike'::swipe_kind END
+    WHERE user_is_liked1 IS NOT NULL;
+UPDATE swipes SET kind2 = CASE WHEN user_is_liked2 THEN 'like'::swipe_kind ELSE 'dislike'::swipe_kind END
+    WHERE user_is_liked2 IS NOT NULL;
+
+ALTER TABLE swipe_history ADD COLUMN kind swipe_kind;
+UPDATE swipe_history SET kind = CASE WHEN is_like THEN 'like'::swipe_kind ELSE 'dislike'::swipe_kind END;
+ALTER TABLE swipe_history ALTER COLUMN kind SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE swipe_history DROP COLUMN kind;
+ALTER TABLE swipes DROP COLUMN kind1;
+ALTER TABLE swipes DROP COLUMN kind2;
+DROP TYPE swipe_kind;
+-- +goose StatementEnd
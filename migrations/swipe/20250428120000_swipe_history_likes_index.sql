@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_swipe_history_likes ON swipe_history(swiper_id, created_at) WHERE is_like AND rewound_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_swipe_history_likes;
+-- +goose StatementEnd
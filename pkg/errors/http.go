@@ -1,9 +1,11 @@
 package http_errors
 
 import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"net/http"
+	"time"
 )
 
 const (
@@ -12,11 +14,69 @@ const (
 	InvalidNameOrPassword = "invalid email or password"
 	LocationIsInvalid     = "location is invalid"
 	InvalidAge            = "the age must be more than 16 and less than 110"
+	InvalidAgeRange       = "the minimum age must not be greater than the maximum age"
 	InvalidDistance       = "the distance must be more than 3 and less than 50"
-	InvalidGender         = "the gender can only be male or female"
+	InvalidGender         = "the gender can only be male, female, non_binary, genderqueer, genderfluid, agender or other"
 	InvalidCity           = "invalid city"
+	MatchNotFound         = "match not found"
+	NotMatched            = "users are not matched"
+	ConversationNotFound  = "conversation not found"
+	InvalidMessage        = "the message must be between 1 and 2000 characters"
+	InvalidPageToken      = "invalid page token"
+	NothingToRewind       = "there is no swipe to rewind"
+	RewindWindowExpired   = "the last swipe can no longer be rewound"
+	RewindMatched         = "the last swipe has already produced a match"
+	SuperLikesExhausted   = "the daily super like limit has been reached"
+	LikesExhausted        = "the daily like limit has been reached"
+	InvalidSwipeKind      = "the kind can only be dislike, like or super_like"
+	TooManyLocationJumps  = "the location has been changed too many times, try again later"
+	InvalidReportReason   = "the reason can only be spam, fake, inappropriate, harassment, underage or other"
+	UserBlocked           = "the user is blocked"
+	ReportNotFound        = "report not found"
+	InvalidReportStatus   = "the status can only be resolved or dismissed"
+	InvalidSanctionKind   = "the kind can only be suspend or ban"
+	InvalidSanctionReason = "the reason must be between 1 and 500 characters"
+	InvalidSuspension     = "a suspension must end in the future"
+	InvalidTravelDates    = "a trip must end in the future and after it starts"
+	TravelTooLong         = "a trip can last at most 30 days"
+	AccountBanned         = "the account is banned"
+	AccountSuspended      = "the account is suspended"
 )
 
+const quotaExceededReason = "QUOTA_EXCEEDED"
+
+// QuotaExceeded builds a ResourceExhausted error that carries the time the quota resets.
+func QuotaExceeded(mes string, resetAt time.Time) error {
+	st, err := status.New(codes.ResourceExhausted, mes).WithDetails(&errdetails.ErrorInfo{
+		Reason:   quotaExceededReason,
+		Metadata: map[string]string{"reset_at": resetAt.UTC().Format(time.RFC3339)},
+	})
+	if err != nil {
+		return status.Errorf(codes.ResourceExhausted, mes)
+	}
+	return st.Err()
+}
+
+// ResetAt returns the reset time attached by QuotaExceeded.
+func ResetAt(err error) (time.Time, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Reason != quotaExceededReason {
+			continue
+		}
+		resetAt, err := time.Parse(time.RFC3339, info.Metadata["reset_at"])
+		if err != nil {
+			return time.Time{}, false
+		}
+		return resetAt, true
+	}
+	return time.Time{}, false
+}
+
 func HandleError(err error) (string, int) {
 	st, ok := status.FromError(err)
 	if !ok {
@@ -33,6 +93,10 @@ func HandleError(err error) (string, int) {
 		code = 403
 	case codes.NotFound:
 		code = 404
+	case codes.FailedPrecondition:
+		code = 409
+	case codes.ResourceExhausted:
+		code = 429
 	default:
 		code = 500
 		mes = http.StatusText(http.StatusInternalServerError)
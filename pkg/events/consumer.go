@@ -0,0 +1,293 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"flame/pkg/db"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+const (
+	defaultBlock          = 5 * time.Second
+	defaultBatchSize      = 50
+	defaultRetryDelay     = time.Second
+	defaultIdempotencyTTL = 7 * 24 * time.Hour
+	defaultMaxDeliveries  = 5
+	claimInterval         = 30 * time.Second
+	claimMinIdle          = time.Minute
+)
+
+type HandlerFunc func(ctx context.Context, event *Event) error
+
+type ConsumerDeps struct {
+	Redis          *db.Redis
+	Logger         *slog.Logger
+	Stream         string
+	Group          string
+	Name           string
+	Handler        HandlerFunc
+	IdempotencyTTL time.Duration
+	MaxDeliveries  int64
+	RetryDelay     time.Duration
+}
+
+// Consumer reads a stream as a member of a consumer group. A message is acknowledged only after
+// the handler succeeded, messages left pending by a failure or a restart are delivered again.
+// Events that were already handled by the group are recognised by their id and skipped.
+// A message that failed MaxDeliveries times is moved to the dead-letter stream, so it does not
+// hold back the ones after it forever.
+type Consumer struct {
+	Redis          *db.Redis
+	Logger         *slog.Logger
+	Stream         string
+	Group          string
+	Name           string
+	Handler        HandlerFunc
+	IdempotencyTTL time.Duration
+	MaxDeliveries  int64
+	RetryDelay     time.Duration
+}
+
+func NewConsumer(deps *ConsumerDeps) *Consumer {
+	consumer := &Consumer{
+		Redis:          deps.Redis,
+		Logger:         deps.Logger,
+		Stream:         deps.Stream,
+		Group:          deps.Group,
+		Name:           deps.Name,
+		Handler:        deps.Handler,
+		IdempotencyTTL: deps.IdempotencyTTL,
+		MaxDeliveries:  deps.MaxDeliveries,
+		RetryDelay:     deps.RetryDelay,
+	}
+	if consumer.IdempotencyTTL <= 0 {
+		consumer.IdempotencyTTL = defaultIdempotencyTTL
+	}
+	if consumer.MaxDeliveries <= 0 {
+		consumer.MaxDeliveries = defaultMaxDeliveries
+	}
+	if consumer.RetryDelay <= 0 {
+		consumer.RetryDelay = defaultRetryDelay
+	}
+	return consumer
+}
+
+// DeadLetterStream is the stream the messages of stream that could not be handled are moved to.
+func DeadLetterStream(stream string) string {
+	return stream + ":dead"
+}
+
+func (consumer *Consumer) Run(ctx context.Context) error {
+	err := consumer.Redis.XGroupCreateMkStream(ctx, consumer.Stream, consumer.Group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	// Pending messages of this consumer are handled first, then new ones.
+	start := "0"
+	var lastClaim time.Time
+	for ctx.Err() == nil {
+		if start == ">" && time.Since(lastClaim) > claimInterval {
+			lastClaim = time.Now()
+			if consumer.claimStale(ctx) {
+				start = "0"
+			}
+		}
+		streams, err := consumer.Redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    consumer.Group,
+			Consumer: consumer.Name,
+			Streams:  []string{consumer.Stream, start},
+			Count:    defaultBatchSize,
+			Block:    defaultBlock,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			consumer.Logger.Error(err.Error(),
+				slog.String("Error location", "consumer.Redis.XReadGroup"),
+				slog.String("Stream", consumer.Stream),
+			)
+			sleep(ctx, consumer.RetryDelay)
+			continue
+		}
+		var messages []redis.XMessage
+		for _, stream := range streams {
+			messages = append(messages, stream.Messages...)
+		}
+		if start == "0" && len(messages) == 0 {
+			start = ">"
+			continue
+		}
+		failed := false
+		for _, message := range messages {
+			err = consumer.handle(ctx, message)
+			if err != nil {
+				consumer.Logger.Error(err.Error(),
+					slog.String("Error location", "consumer.handle"),
+					slog.String("Stream", consumer.Stream),
+					slog.String("Message id", message.ID),
+				)
+				if !consumer.deadLetterExhausted(ctx, message, err) {
+					failed = true
+				}
+			}
+		}
+		if failed {
+			start = "0"
+			sleep(ctx, consumer.RetryDelay)
+		}
+	}
+	return nil
+}
+
+// claimStale takes over messages left pending by consumers of the group that went away,
+// for example an instance that was restarted under another name.
+func (consumer *Consumer) claimStale(ctx context.Context) bool {
+	messages, _, err := consumer.Redis.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   consumer.Stream,
+		Group:    consumer.Group,
+		Consumer: consumer.Name,
+		MinIdle:  claimMinIdle,
+		Start:    "0-0",
+		Count:    defaultBatchSize,
+	}).Result()
+	if err != nil {
+		consumer.Logger.Error(err.Error(),
+			slog.String("Error location", "consumer.Redis.XAutoClaim"),
+			slog.String("Stream", consumer.Stream),
+		)
+		return false
+	}
+	return len(messages) != 0
+}
+
+func (consumer *Consumer) handle(ctx context.Context, message redis.XMessage) error {
+	event, err := FromMessage(message)
+	if err != nil {
+		// A malformed message can never be handled, so it is not retried.
+		consumer.Logger.Error(err.Error(),
+			slog.String("Error location", "events.FromMessage"),
+			slog.String("Message id", message.ID),
+		)
+		return consumer.deadLetter(ctx, message, err)
+	}
+	event.Stream = consumer.Stream
+	processedKey := fmt.Sprintf("events:%s:processed:%s", consumer.Group, event.Id)
+	processed, err := consumer.Redis.Exists(ctx, processedKey).Result()
+	if err != nil {
+		return err
+	}
+	if processed == 0 {
+		err = consumer.Handler(ctx, event)
+		if err != nil {
+			return err
+		}
+		err = consumer.Redis.Set(ctx, processedKey, 1, consumer.IdempotencyTTL).Err()
+		if err != nil {
+			return err
+		}
+	}
+	return consumer.ack(ctx, message.ID)
+}
+
+// deadLetterExhausted moves message to the dead-letter stream if it was delivered MaxDeliveries
+// times and reports whether it did. The delivery count is the one Redis keeps for pending
+// messages, so it survives restarts.
+func (consumer *Consumer) deadLetterExhausted(ctx context.Context, message redis.XMessage, cause error) bool {
+	pending, err := consumer.Redis.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: consumer.Stream,
+		Group:  consumer.Group,
+		Start:  message.ID,
+		End:    message.ID,
+		Count:  1,
+	}).Result()
+	if err != nil {
+		consumer.Logger.Error(err.Error(),
+			slog.String("Error location", "consumer.Redis.XPendingExt"),
+			slog.String("Stream", consumer.Stream),
+			slog.String("Message id", message.ID),
+		)
+		return false
+	}
+	if len(pending) == 0 || pending[0].RetryCount < consumer.MaxDeliveries {
+		return false
+	}
+	err = consumer.deadLetter(ctx, message, cause)
+	if err != nil {
+		consumer.Logger.Error(err.Error(),
+			slog.String("Error location", "consumer.deadLetter"),
+			slog.String("Stream", consumer.Stream),
+			slog.String("Message id", message.ID),
+		)
+		return false
+	}
+	return true
+}
+
+// deadLetter copies message with the reason it failed to the dead-letter stream and acknowledges
+// it, in one transaction so it is neither lost nor copied twice.
+func (consumer *Consumer) deadLetter(ctx context.Context, message redis.XMessage, cause error) error {
+	values := make(map[string]interface{}, len(message.Values)+3)
+	for key, value := range message.Values {
+		values[key] = value
+	}
+	values["message_id"] = message.ID
+	values["group"] = consumer.Group
+	values["error"] = cause.Error()
+	_, err := consumer.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: DeadLetterStream(consumer.Stream),
+			Values: values,
+		})
+		pipe.XAck(ctx, consumer.Stream, consumer.Group, message.ID)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	consumer.Logger.Warn("Message moved to the dead-letter stream",
+		slog.String("Stream", consumer.Stream),
+		slog.String("Message id", message.ID),
+		slog.String("Error", cause.Error()),
+	)
+	return nil
+}
+
+func (consumer *Consumer) ack(ctx context.Context, id string) error {
+	return consumer.Redis.XAck(ctx, consumer.Stream, consumer.Group, id).Err()
+}
+
+// FromMessage restores the event published by the relay.
+func FromMessage(message redis.XMessage) (*Event, error) {
+	id, _ := message.Values["id"].(string)
+	eventType, _ := message.Values["type"].(string)
+	payload, _ := message.Values["payload"].(string)
+	occurredAt, _ := message.Values["occurred_at"].(string)
+	if id == "" || eventType == "" {
+		return nil, errors.New("malformed event message")
+	}
+	occurred, err := time.Parse(time.RFC3339Nano, occurredAt)
+	if err != nil {
+		return nil, err
+	}
+	return &Event{
+		Id:         id,
+		Type:       Type(eventType),
+		Payload:    []byte(payload),
+		OccurredAt: occurred,
+	}, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
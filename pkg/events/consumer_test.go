@@ -0,0 +1,117 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"flame/pkg/events"
+	"flame/pkg/logger"
+	"flame/tests/mocks"
+	"github.com/go-playground/assert/v2"
+	"github.com/go-redis/redis/v8"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConsumer_DeadLetter(t *testing.T) {
+	const stream = "events:test"
+	const group = "test"
+	event, err := events.New(stream, events.ProfileUpdated, events.ProfileUpdatedPayload{UserId: 1})
+	assert.Equal(t, err, nil)
+	values := map[string]interface{}{
+		"id":          event.Id,
+		"type":        string(event.Type),
+		"payload":     string(event.Payload),
+		"occurred_at": event.OccurredAt.Format(time.RFC3339Nano),
+	}
+	tests := []struct {
+		name     string
+		values   map[string]interface{}
+		failures int
+		calls    int
+		dead     bool
+	}{
+		{
+			name:     "handled after a retry",
+			values:   values,
+			failures: 1,
+			calls:    2,
+			dead:     false,
+		},
+		{
+			name:     "poison message",
+			values:   values,
+			failures: -1,
+			calls:    3,
+			dead:     true,
+		},
+		{
+			name:   "malformed message",
+			values: map[string]interface{}{"type": string(events.ProfileUpdated)},
+			calls:  0,
+			dead:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := mocks.NewRedis(t)
+			var mu sync.Mutex
+			calls := 0
+			consumer := events.NewConsumer(&events.ConsumerDeps{
+				Redis:  client,
+				Logger: logger.NewLogger(os.Stdout),
+				Stream: stream,
+				Group:  group,
+				Name:   "consumer",
+				Handler: func(ctx context.Context, event *events.Event) error {
+					mu.Lock()
+					defer mu.Unlock()
+					calls++
+					if tt.failures < 0 || calls <= tt.failures {
+						return errors.New("handler failed")
+					}
+					return nil
+				},
+				MaxDeliveries: 3,
+				RetryDelay:    time.Millisecond,
+			})
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				consumer.Run(ctx)
+				close(done)
+			}()
+			err := client.XAdd(ctx, &redis.XAddArgs{Stream: stream, Values: tt.values}).Err()
+			assert.Equal(t, err, nil)
+
+			deadline := time.Now().Add(2 * time.Second)
+			for time.Now().Before(deadline) {
+				pending, err := client.XPendingExt(ctx, &redis.XPendingExtArgs{
+					Stream: stream,
+					Group:  group,
+					Start:  "-",
+					End:    "+",
+					Count:  10,
+				}).Result()
+				if err == nil && len(pending) == 0 && server.Calls["XACK"] > 0 {
+					break
+				}
+				time.Sleep(5 * time.Millisecond)
+			}
+			cancel()
+			<-done
+
+			mu.Lock()
+			assert.Equal(t, calls, tt.calls)
+			mu.Unlock()
+			dead := server.Stream(events.DeadLetterStream(stream))
+			assert.Equal(t, len(dead) == 1, tt.dead)
+			if tt.dead {
+				assert.Equal(t, dead[0]["group"], group)
+				assert.Equal(t, dead[0]["message_id"], "1-0")
+				assert.Equal(t, dead[0]["type"], string(events.ProfileUpdated))
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package events
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+type Type string
+
+const (
+	UserRegistered  Type = "user.registered"
+	ProfileUpdated  Type = "user.profile_updated"
+	LocationChanged Type = "user.location_changed"
+	AccountDeleted  Type = "user.deleted"
+	Swiped          Type = "swipe.created"
+	Matched         Type = "swipe.matched"
+)
+
+// Streams the services publish to. Every event of a service goes to the same stream so that
+// consumers see them in the order they were committed.
+const (
+	AccountStream = "events:account"
+	SwipesStream  = "events:swipes"
+)
+
+// Event is the envelope stored in the outbox and published to a stream.
+// Id is unique per event and is used by consumers as the idempotency key.
+type Event struct {
+	Id         string          `db:"id"`
+	Stream     string          `db:"stream"`
+	Type       Type            `db:"type"`
+	Payload    json.RawMessage `db:"payload"`
+	OccurredAt time.Time       `db:"occurred_at"`
+}
+
+type UserRegisteredPayload struct {
+	UserId int64 `json:"user_id"`
+}
+
+type ProfileUpdatedPayload struct {
+	UserId int64 `json:"user_id"`
+}
+
+type LocationChangedPayload struct {
+	UserId   int64  `json:"user_id"`
+	Location string `json:"location"`
+}
+
+// AccountDeletedPayload is the payload of AccountDeleted. Accounts cannot be deleted yet, so
+// nothing publishes it.
+type AccountDeletedPayload struct {
+	UserId int64 `json:"user_id"`
+}
+
+type SwipedPayload struct {
+	SwiperId int64  `json:"swiper_id"`
+	TargetId int64  `json:"target_id"`
+	Kind     string `json:"kind"`
+}
+
+type MatchedPayload struct {
+	MatchId int64 `json:"match_id"`
+	UserId1 int64 `json:"user_id1"`
+	UserId2 int64 `json:"user_id2"`
+}
+
+func New(stream string, eventType Type, payload any) (*Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newId()
+	if err != nil {
+		return nil, err
+	}
+	return &Event{
+		Id:         id,
+		Stream:     stream,
+		Type:       eventType,
+		Payload:    data,
+		OccurredAt: time.Now().UTC(),
+	}, nil
+}
+
+// Decode unmarshals the payload of the event into v.
+func (event *Event) Decode(v any) error {
+	return json.Unmarshal(event.Payload, v)
+}
+
+func newId() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"github.com/go-playground/assert/v2"
+	"github.com/go-redis/redis/v8"
+	"testing"
+	"time"
+)
+
+func TestFromMessage(t *testing.T) {
+	event, err := New(SwipesStream, Swiped, SwipedPayload{SwiperId: 1, TargetId: 2, Kind: "like"})
+	assert.Equal(t, err, nil)
+
+	tests := []struct {
+		name    string
+		message redis.XMessage
+		isErr   bool
+	}{
+		{
+			name: "success",
+			message: redis.XMessage{ID: "1-0", Values: map[string]interface{}{
+				"id":          event.Id,
+				"type":        string(event.Type),
+				"payload":     string(event.Payload),
+				"occurred_at": event.OccurredAt.Format(time.RFC3339Nano),
+			}},
+			isErr: false,
+		},
+		{
+			name:    "no id",
+			message: redis.XMessage{ID: "2-0", Values: map[string]interface{}{"type": string(Swiped)}},
+			isErr:   true,
+		},
+		{
+			name: "bad time",
+			message: redis.XMessage{ID: "3-0", Values: map[string]interface{}{
+				"id":          event.Id,
+				"type":        string(event.Type),
+				"occurred_at": "yesterday",
+			}},
+			isErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := FromMessage(tt.message)
+			assert.Equal(t, err != nil, tt.isErr)
+			if tt.isErr {
+				return
+			}
+			assert.Equal(t, res.Id, event.Id)
+			assert.Equal(t, res.Type, Swiped)
+			assert.Equal(t, res.OccurredAt.Equal(event.OccurredAt), true)
+			var payload SwipedPayload
+			assert.Equal(t, res.Decode(&payload), nil)
+			assert.Equal(t, payload, SwipedPayload{SwiperId: 1, TargetId: 2, Kind: "like"})
+		})
+	}
+}
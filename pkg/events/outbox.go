@@ -0,0 +1,36 @@
+package events
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// Save writes the event to the outbox table. It must be called with the transaction that
+// changes the state the event describes, the relay publishes it once the transaction commits.
+func Save(tx Execer, event *Event) error {
+	_, err := tx.Exec(`INSERT INTO outbox (id, stream, type, payload, occurred_at) VALUES ($1,$2,$3,$4,$5)`,
+		event.Id, event.Stream, event.Type, []byte(event.Payload), event.OccurredAt)
+	return err
+}
+
+// Publish creates an event and saves it to the outbox.
+func Publish(tx Execer, stream string, eventType Type, payload any) error {
+	event, err := New(stream, eventType, payload)
+	if err != nil {
+		return err
+	}
+	return Save(tx, event)
+}
+
+// Purge deletes events published before the given time.
+func Purge(db Execer, before time.Time) (int64, error) {
+	result, err := db.Exec(`DELETE FROM outbox WHERE published_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,134 @@
+package events
+
+import (
+	"context"
+	"flame/pkg/db"
+	"github.com/go-redis/redis/v8"
+	"github.com/lib/pq"
+	"log/slog"
+	"time"
+)
+
+const (
+	defaultRelayInterval  = time.Second
+	defaultRelayBatchSize = 100
+	defaultStreamMaxLen   = 100000
+)
+
+type RelayDeps struct {
+	DB        *db.DB
+	Redis     *db.Redis
+	Logger    *slog.Logger
+	Interval  time.Duration
+	BatchSize int
+	MaxLen    int64
+}
+
+// Relay moves committed events from the outbox to Redis Streams. An event is marked as published
+// only after XADD succeeded, so it may be published more than once but is never lost.
+type Relay struct {
+	DB        *db.DB
+	Redis     *db.Redis
+	Logger    *slog.Logger
+	Interval  time.Duration
+	BatchSize int
+	MaxLen    int64
+}
+
+func NewRelay(deps *RelayDeps) *Relay {
+	relay := &Relay{
+		DB:        deps.DB,
+		Redis:     deps.Redis,
+		Logger:    deps.Logger,
+		Interval:  deps.Interval,
+		BatchSize: deps.BatchSize,
+		MaxLen:    deps.MaxLen,
+	}
+	if relay.Interval <= 0 {
+		relay.Interval = defaultRelayInterval
+	}
+	if relay.BatchSize <= 0 {
+		relay.BatchSize = defaultRelayBatchSize
+	}
+	if relay.MaxLen <= 0 {
+		relay.MaxLen = defaultStreamMaxLen
+	}
+	return relay
+}
+
+func (relay *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(relay.Interval)
+	defer ticker.Stop()
+	for {
+		for {
+			n, err := relay.publishBatch(ctx)
+			if err != nil {
+				relay.Logger.Error(err.Error(), slog.String("Error location", "relay.publishBatch"))
+				break
+			}
+			if n < relay.BatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (relay *Relay) publishBatch(ctx context.Context) (int, error) {
+	tr, err := relay.DB.Beginx()
+	if err != nil {
+		return 0, err
+	}
+	var batch []Event
+	err = tr.Select(&batch, `SELECT id, stream, type, payload, occurred_at FROM outbox
+		WHERE published_at IS NULL
+		ORDER BY occurred_at, id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`, relay.BatchSize)
+	if err != nil {
+		tr.Rollback()
+		return 0, err
+	}
+	if len(batch) == 0 {
+		tr.Rollback()
+		return 0, nil
+	}
+	ids := make([]string, 0, len(batch))
+	for _, event := range batch {
+		err = relay.Redis.XAdd(ctx, &redis.XAddArgs{
+			Stream: event.Stream,
+			MaxLen: relay.MaxLen,
+			Approx: true,
+			Values: map[string]interface{}{
+				"id":          event.Id,
+				"type":        string(event.Type),
+				"payload":     string(event.Payload),
+				"occurred_at": event.OccurredAt.UTC().Format(time.RFC3339Nano),
+			},
+		}).Err()
+		if err != nil {
+			relay.Logger.Error(err.Error(),
+				slog.String("Error location", "relay.Redis.XAdd"),
+				slog.String("Event id", event.Id),
+			)
+			break
+		}
+		ids = append(ids, event.Id)
+	}
+	if len(ids) != 0 {
+		_, err = tr.Exec(`UPDATE outbox SET published_at = now() WHERE id = ANY($1)`, pq.Array(ids))
+		if err != nil {
+			tr.Rollback()
+			return 0, err
+		}
+	}
+	err = tr.Commit()
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
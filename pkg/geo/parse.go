@@ -0,0 +1,167 @@
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrEmpty     = errors.New("location is empty")
+	ErrFormat    = errors.New(`unknown format, expected "(lon lat)", "POINT(lon lat)", {"lat": .., "lon": ..} or a GeoJSON Point`)
+	ErrLongitude = errors.New("longitude must be between -180 and 180")
+	ErrLatitude  = errors.New("latitude must be between -90 and 90")
+)
+
+// Parse reads a location in one of the accepted formats:
+//
+//	(37.6173 55.7558)                                  lon lat tuple
+//	POINT(37.6173 55.7558), SRID=4326;POINT(...)       WKT or EWKT
+//	{"lat": 55.7558, "lon": 37.6173}                   JSON object
+//	{"type": "Point", "coordinates": [37.6173, 55.7558]} GeoJSON Point
+//
+// and checks that the coordinates are in range.
+func Parse(s string) (Point, error) {
+	s = strings.TrimSpace(s)
+	var p Point
+	var err error
+	switch {
+	case s == "":
+		return Point{}, ErrEmpty
+	case strings.HasPrefix(s, "{"):
+		p, err = parseObject(s)
+	case strings.HasPrefix(s, "("):
+		p, err = parseTuple(s)
+	default:
+		p, err = parseWKT(s)
+	}
+	if err != nil {
+		return Point{}, err
+	}
+	return p, p.Validate()
+}
+
+// Validate checks that the point is a valid WGS 84 position.
+func (p Point) Validate() error {
+	if p.Lon < -180 || p.Lon > 180 {
+		return ErrLongitude
+	}
+	if p.Lat < -90 || p.Lat > 90 {
+		return ErrLatitude
+	}
+	return nil
+}
+
+// String formats the point as the "(lon lat)" tuple the services exchange.
+func (p Point) String() string {
+	return fmt.Sprintf("(%g %g)", p.Lon, p.Lat)
+}
+
+func parseTuple(s string) (Point, error) {
+	if !strings.HasSuffix(s, ")") {
+		return Point{}, ErrFormat
+	}
+	return parseCoordinates(s[1 : len(s)-1])
+}
+
+func parseWKT(s string) (Point, error) {
+	if srid, rest, ok := strings.Cut(s, ";"); ok {
+		if !strings.EqualFold(strings.TrimSpace(srid), "SRID=4326") {
+			return Point{}, fmt.Errorf("%w, only SRID 4326 is supported", ErrFormat)
+		}
+		s = strings.TrimSpace(rest)
+	}
+	if len(s) < len("POINT") || !strings.EqualFold(s[:len("POINT")], "POINT") {
+		return Point{}, ErrFormat
+	}
+	return parseTuple(strings.TrimSpace(s[len("POINT"):]))
+}
+
+func parseCoordinates(s string) (Point, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '\t'
+	})
+	if len(fields) != 2 {
+		return Point{}, ErrFormat
+	}
+	lon, err := parseFloat(fields[0])
+	if err != nil {
+		return Point{}, err
+	}
+	lat, err := parseFloat(fields[1])
+	if err != nil {
+		return Point{}, err
+	}
+	return Point{Lon: lon, Lat: lat}, nil
+}
+
+func parseFloat(s string) (float64, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, ErrFormat
+	}
+	return v, nil
+}
+
+func parseObject(s string) (Point, error) {
+	var obj struct {
+		Type        *string   `json:"type"`
+		Coordinates []float64 `json:"coordinates"`
+		Lat         *float64  `json:"lat"`
+		Lon         *float64  `json:"lon"`
+	}
+	err := json.Unmarshal([]byte(s), &obj)
+	if err != nil {
+		return Point{}, ErrFormat
+	}
+	if obj.Type != nil {
+		// A third coordinate is the altitude, it is allowed and ignored.
+		if *obj.Type != "Point" || len(obj.Coordinates) < 2 || len(obj.Coordinates) > 3 {
+			return Point{}, ErrFormat
+		}
+		return Point{Lon: obj.Coordinates[0], Lat: obj.Coordinates[1]}, nil
+	}
+	if obj.Lat == nil || obj.Lon == nil {
+		return Point{}, ErrFormat
+	}
+	return Point{Lon: *obj.Lon, Lat: *obj.Lat}, nil
+}
+
+// Location is a request field holding a location as the client sent it: a string in one of the
+// formats Parse accepts, a {"lat", "lon"} object or a GeoJSON Point. Decoding never fails, the
+// value is checked by the "location" validation of pkg/req so the error names the field.
+type Location struct {
+	Raw string
+}
+
+func (l *Location) UnmarshalJSON(data []byte) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		l.Raw = s
+		return nil
+	}
+	if string(data) == "null" {
+		l.Raw = ""
+		return nil
+	}
+	l.Raw = string(data)
+	return nil
+}
+
+// Point parses the location.
+func (l Location) Point() (Point, error) {
+	return Parse(l.Raw)
+}
+
+// String returns the location as a "(lon lat)" tuple, or an empty string if it is not set or
+// invalid.
+func (l Location) String() string {
+	p, err := l.Point()
+	if err != nil {
+		return ""
+	}
+	return p.String()
+}
@@ -0,0 +1,73 @@
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-playground/assert/v2"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	moscow := Point{Lon: 37.6173, Lat: 55.7558}
+	tests := []struct {
+		name  string
+		input string
+		point Point
+		err   error
+	}{
+		{name: "tuple", input: "(37.6173 55.7558)", point: moscow},
+		{name: "tuple with comma", input: " (37.6173, 55.7558) ", point: moscow},
+		{name: "wkt", input: "POINT(37.6173 55.7558)", point: moscow},
+		{name: "wkt lower case with space", input: "point (37.6173 55.7558)", point: moscow},
+		{name: "ewkt", input: "SRID=4326;POINT(37.6173 55.7558)", point: moscow},
+		{name: "json object", input: `{"lat": 55.7558, "lon": 37.6173}`, point: moscow},
+		{name: "geojson", input: `{"type": "Point", "coordinates": [37.6173, 55.7558]}`, point: moscow},
+		{name: "geojson with altitude", input: `{"type": "Point", "coordinates": [37.6173, 55.7558, 120]}`, point: moscow},
+		{name: "empty", input: " ", err: ErrEmpty},
+		{name: "unclosed tuple", input: "(37.6173 55.7558", err: ErrFormat},
+		{name: "one coordinate", input: "(37.6173)", err: ErrFormat},
+		{name: "three coordinates", input: "(37.6173 55.7558 1)", err: ErrFormat},
+		{name: "not a number", input: "(east north)", err: ErrFormat},
+		{name: "nan", input: "(NaN 55.7558)", err: ErrFormat},
+		{name: "json without lon", input: `{"lat": 55.7558}`, err: ErrFormat},
+		{name: "geojson polygon", input: `{"type": "Polygon", "coordinates": [[[0, 0], [1, 1], [1, 0], [0, 0]]]}`, err: ErrFormat},
+		{name: "other srid", input: "SRID=3857;POINT(4187526 7509131)", err: ErrFormat},
+		{name: "garbage", input: "Moscow", err: ErrFormat},
+		{name: "longitude out of range", input: "(190 55.7558)", err: ErrLongitude},
+		{name: "latitude out of range", input: `{"lat": -91, "lon": 37.6173}`, err: ErrLatitude},
+		{name: "swapped", input: "(55.7558 137.6173)", err: ErrLatitude},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			point, err := Parse(test.input)
+			if test.err != nil {
+				assert.Equal(t, true, errors.Is(err, test.err))
+				return
+			}
+			assert.Equal(t, nil, err)
+			assert.Equal(t, test.point, point)
+		})
+	}
+}
+
+func TestLocationUnmarshal(t *testing.T) {
+	var body struct {
+		Location Location `json:"location"`
+	}
+	for _, input := range []string{
+		`{"location": "(37.6173 55.7558)"}`,
+		`{"location": {"lat": 55.7558, "lon": 37.6173}}`,
+		`{"location": {"type": "Point", "coordinates": [37.6173, 55.7558]}}`,
+	} {
+		err := json.Unmarshal([]byte(input), &body)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, "(37.6173 55.7558)", body.Location.String())
+	}
+
+	body.Location = Location{}
+	err := json.Unmarshal([]byte(`{"location": {"lat": 95, "lon": 37.6173}}`), &body)
+	assert.Equal(t, nil, err)
+	_, err = body.Location.Point()
+	assert.Equal(t, ErrLatitude, err)
+	assert.Equal(t, "", body.Location.String())
+}
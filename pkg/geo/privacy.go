@@ -0,0 +1,80 @@
+package geo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"github.com/umahmood/haversine"
+)
+
+const (
+	// Grid is the step in degrees locations are snapped to before they are stored or cached,
+	// about 1.1 km. Migration 20250526120000_snap_locations uses the same value.
+	Grid = 0.01
+	// NoiseKm bounds the noise added to displayed distances.
+	NoiseKm = 0.5
+	// NearKm is the lowest distance shown as a number, closer users are shown as "less than NearKm".
+	NearKm = 2
+)
+
+type Point struct {
+	Lon float64
+	Lat float64
+}
+
+// Snap rounds the point to the nearest node of the grid.
+func Snap(p Point) Point {
+	return Point{
+		Lon: snap(p.Lon),
+		Lat: snap(p.Lat),
+	}
+}
+
+func snap(v float64) float64 {
+	// Rounding the result again removes the binary error of the multiplication, 55.76 and not
+	// 55.760000000000005.
+	return math.Round(math.Round(v/Grid)*Grid*1e6) / 1e6
+}
+
+// EWKT formats the point for ST_GeographyFromText.
+func (p Point) EWKT() string {
+	return fmt.Sprintf("SRID=4326;POINT(%g %g)", p.Lon, p.Lat)
+}
+
+// DisplayDistance returns the distance between a viewer and a candidate as it may be shown to the
+// viewer: in metres and as a label. The distance gets noise that depends on the viewer, the
+// candidate and the grid cell of the viewer, so it is the same on every request from the same
+// place and cannot be averaged out, then it is rounded to whole kilometres, and everything closer
+// than NearKm is shown as such.
+func DisplayDistance(viewerId, candidateId int64, viewer, candidate Point) (int32, string) {
+	_, km := haversine.Distance(
+		haversine.Coord{Lat: viewer.Lat, Lon: viewer.Lon},
+		haversine.Coord{Lat: candidate.Lat, Lon: candidate.Lon},
+	)
+	km += noise(viewerId, candidateId, Snap(viewer))
+	if km < NearKm {
+		return NearKm * 1000, fmt.Sprintf("less than %d km", NearKm)
+	}
+	rounded := int32(math.Round(km))
+	return rounded * 1000, fmt.Sprintf("%d km", rounded)
+}
+
+// noise returns a value in [-NoiseKm, NoiseKm] that is stable for its arguments.
+func noise(viewerId, candidateId int64, cell Point) float64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, v := range []uint64{
+		uint64(viewerId),
+		uint64(candidateId),
+		// Through int64, converting a negative float to uint64 is implementation-defined.
+		uint64(int64(math.Round(cell.Lon / Grid))),
+		uint64(int64(math.Round(cell.Lat / Grid))),
+	} {
+		binary.LittleEndian.PutUint64(buf[:], v)
+		h.Write(buf[:])
+	}
+	unit := float64(h.Sum64()>>11) / float64(1<<53)
+	return (unit*2 - 1) * NoiseKm
+}
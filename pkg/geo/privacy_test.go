@@ -0,0 +1,73 @@
+package geo
+
+import (
+	"github.com/go-playground/assert/v2"
+	"testing"
+)
+
+func TestSnap(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Point
+		out  Point
+	}{
+		{name: "rounds up", in: Point{Lon: 37.61731, Lat: 55.75581}, out: Point{Lon: 37.62, Lat: 55.76}},
+		{name: "rounds down", in: Point{Lon: 37.6149, Lat: 55.7549}, out: Point{Lon: 37.61, Lat: 55.75}},
+		{name: "negative", in: Point{Lon: -73.98567, Lat: -40.74844}, out: Point{Lon: -73.99, Lat: -40.75}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.out, Snap(test.in))
+		})
+	}
+	assert.Equal(t, "SRID=4326;POINT(37.62 55.76)", Snap(Point{Lon: 37.61731, Lat: 55.75581}).EWKT())
+}
+
+func TestDisplayDistance(t *testing.T) {
+	viewer := Point{Lon: 37.6173, Lat: 55.7558}
+
+	meters, label := DisplayDistance(1, 2, viewer, viewer)
+	assert.Equal(t, int32(NearKm*1000), meters)
+	assert.Equal(t, "less than 2 km", label)
+
+	// About 11.1 km to the north.
+	candidate := Point{Lon: 37.6173, Lat: 55.8558}
+	meters, label = DisplayDistance(1, 2, viewer, candidate)
+	assert.Equal(t, int32(0), meters%1000)
+	assert.Equal(t, true, meters >= 11000 && meters <= 12000)
+	assert.Equal(t, label, map[int32]string{11000: "11 km", 12000: "12 km"}[meters])
+
+	// Asking again from the same place does not give a different distance to average.
+	for i := 0; i < 10; i++ {
+		repeated, _ := DisplayDistance(1, 2, viewer, candidate)
+		assert.Equal(t, meters, repeated)
+	}
+}
+
+func TestNoiseBounds(t *testing.T) {
+	cell := Snap(Point{Lon: 37.6173, Lat: 55.7558})
+	for viewerId := int64(1); viewerId < 200; viewerId++ {
+		n := noise(viewerId, 7, cell)
+		assert.Equal(t, true, n >= -NoiseKm && n <= NoiseKm)
+		assert.Equal(t, n, noise(viewerId, 7, cell))
+	}
+}
+
+func TestNoiseNegativeCells(t *testing.T) {
+	// Western and southern cells must each get their own noise, not all hash to the same input.
+	cells := []Point{
+		{Lon: -73.99, Lat: -40.75},
+		{Lon: -74.00, Lat: -40.75},
+		{Lon: -73.99, Lat: -40.76},
+		{Lon: -0.01, Lat: 51.5},
+		{Lon: 73.99, Lat: 40.75},
+	}
+	seen := make(map[float64]Point, len(cells))
+	for _, cell := range cells {
+		n := noise(1, 2, cell)
+		assert.Equal(t, true, n >= -NoiseKm && n <= NoiseKm)
+		_, ok := seen[n]
+		assert.Equal(t, false, ok)
+		seen[n] = cell
+	}
+}
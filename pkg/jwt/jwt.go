@@ -6,7 +6,8 @@ import (
 )
 
 type Data struct {
-	Id int64
+	Id   int64
+	Role string
 }
 
 type JWT struct {
@@ -49,8 +50,11 @@ func (j *JWT) Parse(token string) (bool, *Data) {
 		return false, nil
 	}
 
-	id := int64(t.Claims.(jwt.MapClaims)["Id"].(float64))
+	claims := t.Claims.(jwt.MapClaims)
+	id := int64(claims["Id"].(float64))
+	role, _ := claims["Role"].(string)
 	return t.Valid, &Data{
-		Id: id,
+		Id:   id,
+		Role: role,
 	}
 }
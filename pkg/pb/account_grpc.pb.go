@@ -29,6 +29,18 @@ const (
 	Account_UploadPhoto_FullMethodName       = "/Account/UploadPhoto"
 	Account_DeletePhoto_FullMethodName       = "/Account/DeletePhoto"
 	Account_UpdateLocation_FullMethodName    = "/Account/UpdateLocation"
+	Account_Block_FullMethodName             = "/Account/Block"
+	Account_Report_FullMethodName            = "/Account/Report"
+	Account_IsBlocked_FullMethodName         = "/Account/IsBlocked"
+	Account_GetReports_FullMethodName        = "/Account/GetReports"
+	Account_ResolveReport_FullMethodName     = "/Account/ResolveReport"
+	Account_GetReportedUser_FullMethodName   = "/Account/GetReportedUser"
+	Account_SanctionUser_FullMethodName      = "/Account/SanctionUser"
+	Account_RemovePhoto_FullMethodName       = "/Account/RemovePhoto"
+	Account_GetStatus_FullMethodName         = "/Account/GetStatus"
+	Account_SetTravel_FullMethodName         = "/Account/SetTravel"
+	Account_GetTravel_FullMethodName         = "/Account/GetTravel"
+	Account_EndTravel_FullMethodName         = "/Account/EndTravel"
 )
 
 // AccountClient is the client API for Account service.
@@ -44,6 +56,18 @@ type AccountClient interface {
 	UploadPhoto(ctx context.Context, in *UploadPhotoReq, opts ...grpc.CallOption) (*UploadPhotoRes, error)
 	DeletePhoto(ctx context.Context, in *DeletePhotoReq, opts ...grpc.CallOption) (*DeletePhotoRes, error)
 	UpdateLocation(ctx context.Context, in *UpdateLocationReq, opts ...grpc.CallOption) (*UpdateLocationRes, error)
+	Block(ctx context.Context, in *BlockReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Report(ctx context.Context, in *ReportReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	IsBlocked(ctx context.Context, in *IsBlockedReq, opts ...grpc.CallOption) (*IsBlockedRes, error)
+	GetReports(ctx context.Context, in *GetReportsReq, opts ...grpc.CallOption) (*GetReportsRes, error)
+	ResolveReport(ctx context.Context, in *ResolveReportReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetReportedUser(ctx context.Context, in *GetReportedUserReq, opts ...grpc.CallOption) (*GetReportedUserRes, error)
+	SanctionUser(ctx context.Context, in *SanctionUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemovePhoto(ctx context.Context, in *RemovePhotoReq, opts ...grpc.CallOption) (*RemovePhotoRes, error)
+	GetStatus(ctx context.Context, in *GetStatusReq, opts ...grpc.CallOption) (*GetStatusRes, error)
+	SetTravel(ctx context.Context, in *SetTravelReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetTravel(ctx context.Context, in *GetTravelReq, opts ...grpc.CallOption) (*GetTravelRes, error)
+	EndTravel(ctx context.Context, in *EndTravelReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type accountClient struct {
@@ -144,6 +168,126 @@ func (c *accountClient) UpdateLocation(ctx context.Context, in *UpdateLocationRe
 	return out, nil
 }
 
+func (c *accountClient) Block(ctx context.Context, in *BlockReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Account_Block_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) Report(ctx context.Context, in *ReportReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Account_Report_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) IsBlocked(ctx context.Context, in *IsBlockedReq, opts ...grpc.CallOption) (*IsBlockedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsBlockedRes)
+	err := c.cc.Invoke(ctx, Account_IsBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) GetReports(ctx context.Context, in *GetReportsReq, opts ...grpc.CallOption) (*GetReportsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportsRes)
+	err := c.cc.Invoke(ctx, Account_GetReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) ResolveReport(ctx context.Context, in *ResolveReportReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Account_ResolveReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) GetReportedUser(ctx context.Context, in *GetReportedUserReq, opts ...grpc.CallOption) (*GetReportedUserRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportedUserRes)
+	err := c.cc.Invoke(ctx, Account_GetReportedUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) SanctionUser(ctx context.Context, in *SanctionUserReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Account_SanctionUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) RemovePhoto(ctx context.Context, in *RemovePhotoReq, opts ...grpc.CallOption) (*RemovePhotoRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePhotoRes)
+	err := c.cc.Invoke(ctx, Account_RemovePhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) GetStatus(ctx context.Context, in *GetStatusReq, opts ...grpc.CallOption) (*GetStatusRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatusRes)
+	err := c.cc.Invoke(ctx, Account_GetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) SetTravel(ctx context.Context, in *SetTravelReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Account_SetTravel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) GetTravel(ctx context.Context, in *GetTravelReq, opts ...grpc.CallOption) (*GetTravelRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTravelRes)
+	err := c.cc.Invoke(ctx, Account_GetTravel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountClient) EndTravel(ctx context.Context, in *EndTravelReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Account_EndTravel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServer is the server API for Account service.
 // All implementations must embed UnimplementedAccountServer
 // for forward compatibility.
@@ -157,6 +301,18 @@ type AccountServer interface {
 	UploadPhoto(context.Context, *UploadPhotoReq) (*UploadPhotoRes, error)
 	DeletePhoto(context.Context, *DeletePhotoReq) (*DeletePhotoRes, error)
 	UpdateLocation(context.Context, *UpdateLocationReq) (*UpdateLocationRes, error)
+	Block(context.Context, *BlockReq) (*emptypb.Empty, error)
+	Report(context.Context, *ReportReq) (*emptypb.Empty, error)
+	IsBlocked(context.Context, *IsBlockedReq) (*IsBlockedRes, error)
+	GetReports(context.Context, *GetReportsReq) (*GetReportsRes, error)
+	ResolveReport(context.Context, *ResolveReportReq) (*emptypb.Empty, error)
+	GetReportedUser(context.Context, *GetReportedUserReq) (*GetReportedUserRes, error)
+	SanctionUser(context.Context, *SanctionUserReq) (*emptypb.Empty, error)
+	RemovePhoto(context.Context, *RemovePhotoReq) (*RemovePhotoRes, error)
+	GetStatus(context.Context, *GetStatusReq) (*GetStatusRes, error)
+	SetTravel(context.Context, *SetTravelReq) (*emptypb.Empty, error)
+	GetTravel(context.Context, *GetTravelReq) (*GetTravelRes, error)
+	EndTravel(context.Context, *EndTravelReq) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAccountServer()
 }
 
@@ -194,6 +350,42 @@ func (UnimplementedAccountServer) DeletePhoto(context.Context, *DeletePhotoReq)
 func (UnimplementedAccountServer) UpdateLocation(context.Context, *UpdateLocationReq) (*UpdateLocationRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLocation not implemented")
 }
+func (UnimplementedAccountServer) Block(context.Context, *BlockReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedAccountServer) Report(context.Context, *ReportReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+}
+func (UnimplementedAccountServer) IsBlocked(context.Context, *IsBlockedReq) (*IsBlockedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBlocked not implemented")
+}
+func (UnimplementedAccountServer) GetReports(context.Context, *GetReportsReq) (*GetReportsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReports not implemented")
+}
+func (UnimplementedAccountServer) ResolveReport(context.Context, *ResolveReportReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedAccountServer) GetReportedUser(context.Context, *GetReportedUserReq) (*GetReportedUserRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportedUser not implemented")
+}
+func (UnimplementedAccountServer) SanctionUser(context.Context, *SanctionUserReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SanctionUser not implemented")
+}
+func (UnimplementedAccountServer) RemovePhoto(context.Context, *RemovePhotoReq) (*RemovePhotoRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePhoto not implemented")
+}
+func (UnimplementedAccountServer) GetStatus(context.Context, *GetStatusReq) (*GetStatusRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedAccountServer) SetTravel(context.Context, *SetTravelReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTravel not implemented")
+}
+func (UnimplementedAccountServer) GetTravel(context.Context, *GetTravelReq) (*GetTravelRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTravel not implemented")
+}
+func (UnimplementedAccountServer) EndTravel(context.Context, *EndTravelReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndTravel not implemented")
+}
 func (UnimplementedAccountServer) mustEmbedUnimplementedAccountServer() {}
 func (UnimplementedAccountServer) testEmbeddedByValue()                 {}
 
@@ -377,6 +569,222 @@ func _Account_UpdateLocation_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Account_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).Block(ctx, req.(*BlockReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).Report(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_Report_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).Report(ctx, req.(*ReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_IsBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBlockedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).IsBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_IsBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).IsBlocked(ctx, req.(*IsBlockedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_GetReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).GetReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_GetReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).GetReports(ctx, req.(*GetReportsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_ResolveReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).ResolveReport(ctx, req.(*ResolveReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_GetReportedUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportedUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).GetReportedUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_GetReportedUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).GetReportedUser(ctx, req.(*GetReportedUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_SanctionUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SanctionUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).SanctionUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_SanctionUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).SanctionUser(ctx, req.(*SanctionUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_RemovePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePhotoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).RemovePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_RemovePhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).RemovePhoto(ctx, req.(*RemovePhotoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).GetStatus(ctx, req.(*GetStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_SetTravel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTravelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).SetTravel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_SetTravel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).SetTravel(ctx, req.(*SetTravelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_GetTravel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTravelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).GetTravel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_GetTravel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).GetTravel(ctx, req.(*GetTravelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Account_EndTravel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndTravelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServer).EndTravel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Account_EndTravel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServer).EndTravel(ctx, req.(*EndTravelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Account_ServiceDesc is the grpc.ServiceDesc for Account service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -420,6 +828,54 @@ var Account_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLocation",
 			Handler:    _Account_UpdateLocation_Handler,
 		},
+		{
+			MethodName: "Block",
+			Handler:    _Account_Block_Handler,
+		},
+		{
+			MethodName: "Report",
+			Handler:    _Account_Report_Handler,
+		},
+		{
+			MethodName: "IsBlocked",
+			Handler:    _Account_IsBlocked_Handler,
+		},
+		{
+			MethodName: "GetReports",
+			Handler:    _Account_GetReports_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _Account_ResolveReport_Handler,
+		},
+		{
+			MethodName: "GetReportedUser",
+			Handler:    _Account_GetReportedUser_Handler,
+		},
+		{
+			MethodName: "SanctionUser",
+			Handler:    _Account_SanctionUser_Handler,
+		},
+		{
+			MethodName: "RemovePhoto",
+			Handler:    _Account_RemovePhoto_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _Account_GetStatus_Handler,
+		},
+		{
+			MethodName: "SetTravel",
+			Handler:    _Account_SetTravel_Handler,
+		},
+		{
+			MethodName: "GetTravel",
+			Handler:    _Account_GetTravel_Handler,
+		},
+		{
+			MethodName: "EndTravel",
+			Handler:    _Account_EndTravel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "account.proto",
@@ -23,14 +23,20 @@ const (
 )
 
 type UserMatch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=Id,json=id,proto3" json:"Id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=Name,json=name,proto3" json:"Name,omitempty"`
-	Age           *int32                 `protobuf:"varint,3,opt,name=Age,json=age,proto3,oneof" json:"Age,omitempty"`
-	City          *string                `protobuf:"bytes,4,opt,name=City,json=city,proto3,oneof" json:"City,omitempty"`
-	Gender        *string                `protobuf:"bytes,5,opt,name=Gender,json=gender,proto3,oneof" json:"Gender,omitempty"`
-	Photo         *UserPhoto             `protobuf:"bytes,6,opt,name=Photo,json=photo,proto3" json:"Photo,omitempty"`
-	Distance      int32                  `protobuf:"varint,7,opt,name=Distance,json=distance,proto3" json:"Distance,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=Id,json=id,proto3" json:"Id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=Name,json=name,proto3" json:"Name,omitempty"`
+	Age          *int32                 `protobuf:"varint,3,opt,name=Age,json=age,proto3,oneof" json:"Age,omitempty"`
+	City         *string                `protobuf:"bytes,4,opt,name=City,json=city,proto3,oneof" json:"City,omitempty"`
+	Gender       *string                `protobuf:"bytes,5,opt,name=Gender,json=gender,proto3,oneof" json:"Gender,omitempty"`
+	Photo        *UserPhoto             `protobuf:"bytes,6,opt,name=Photo,json=photo,proto3" json:"Photo,omitempty"`
+	Distance     int32                  `protobuf:"varint,7,opt,name=Distance,json=distance,proto3" json:"Distance,omitempty"`
+	IsSuperLike  bool                   `protobuf:"varint,8,opt,name=IsSuperLike,json=is_super_like,proto3" json:"IsSuperLike,omitempty"`
+	InterestedIn []string               `protobuf:"bytes,9,rep,name=InterestedIn,json=interested_in,proto3" json:"InterestedIn,omitempty"`
+	// Visiting is set while the user is matched from a travel location.
+	Visiting bool `protobuf:"varint,10,opt,name=Visiting,json=visiting,proto3" json:"Visiting,omitempty"`
+	// DistanceLabel is the rounded Distance for display, "less than 2 km" or whole kilometres.
+	DistanceLabel string `protobuf:"bytes,11,opt,name=DistanceLabel,json=distance_label,proto3" json:"DistanceLabel,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,10 +120,40 @@ func (x *UserMatch) GetDistance() int32 {
 	return 0
 }
 
+func (x *UserMatch) GetIsSuperLike() bool {
+	if x != nil {
+		return x.IsSuperLike
+	}
+	return false
+}
+
+func (x *UserMatch) GetInterestedIn() []string {
+	if x != nil {
+		return x.InterestedIn
+	}
+	return nil
+}
+
+func (x *UserMatch) GetVisiting() bool {
+	if x != nil {
+		return x.Visiting
+	}
+	return false
+}
+
+func (x *UserMatch) GetDistanceLabel() string {
+	if x != nil {
+		return x.DistanceLabel
+	}
+	return ""
+}
+
 type GetMatchingUsersReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	Location      string                 `protobuf:"bytes,2,opt,name=Location,proto3" json:"Location,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -166,9 +202,24 @@ func (x *GetMatchingUsersReq) GetLocation() string {
 	return ""
 }
 
+func (x *GetMatchingUsersReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMatchingUsersReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetMatchingUsersRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*UserMatch           `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=NextPageToken,json=next_page_token,proto3" json:"NextPageToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,6 +261,13 @@ func (x *GetMatchingUsersRes) GetUsers() []*UserMatch {
 	return nil
 }
 
+func (x *GetMatchingUsersRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type UpdateRedisReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
@@ -254,13 +312,185 @@ func (x *UpdateRedisReq) GetUserId() int64 {
 	return 0
 }
 
+type LikeCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserMatch             `protobuf:"bytes,1,opt,name=User,json=user,proto3" json:"User,omitempty"`
+	LikedAt       string                 `protobuf:"bytes,2,opt,name=LikedAt,json=liked_at,proto3" json:"LikedAt,omitempty"`
+	IsSuperLike   bool                   `protobuf:"varint,3,opt,name=IsSuperLike,json=is_super_like,proto3" json:"IsSuperLike,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeCard) Reset() {
+	*x = LikeCard{}
+	mi := &file_matching_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCard) ProtoMessage() {}
+
+func (x *LikeCard) ProtoReflect() protoreflect.Message {
+	mi := &file_matching_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCard.ProtoReflect.Descriptor instead.
+func (*LikeCard) Descriptor() ([]byte, []int) {
+	return file_matching_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LikeCard) GetUser() *UserMatch {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *LikeCard) GetLikedAt() string {
+	if x != nil {
+		return x.LikedAt
+	}
+	return ""
+}
+
+func (x *LikeCard) GetIsSuperLike() bool {
+	if x != nil {
+		return x.IsSuperLike
+	}
+	return false
+}
+
+type GetLikesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLikesReq) Reset() {
+	*x = GetLikesReq{}
+	mi := &file_matching_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikesReq) ProtoMessage() {}
+
+func (x *GetLikesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_matching_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikesReq.ProtoReflect.Descriptor instead.
+func (*GetLikesReq) Descriptor() ([]byte, []int) {
+	return file_matching_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetLikesReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetLikesReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetLikesReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetLikesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likes         []*LikeCard            `protobuf:"bytes,1,rep,name=likes,proto3" json:"likes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=NextPageToken,json=next_page_token,proto3" json:"NextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLikesRes) Reset() {
+	*x = GetLikesRes{}
+	mi := &file_matching_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLikesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLikesRes) ProtoMessage() {}
+
+func (x *GetLikesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_matching_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLikesRes.ProtoReflect.Descriptor instead.
+func (*GetLikesRes) Descriptor() ([]byte, []int) {
+	return file_matching_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetLikesRes) GetLikes() []*LikeCard {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *GetLikesRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 var File_matching_proto protoreflect.FileDescriptor
 
 var file_matching_proto_rawDesc = string([]byte{
 	0x0a, 0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x01, 0x0a,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x02, 0x0a,
 	0x09, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
 	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15,
@@ -272,29 +502,65 @@ var file_matching_proto_rawDesc = string([]byte{
 	0x68, 0x6f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x55, 0x73, 0x65,
 	0x72, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x0a,
 	0x08, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x41, 0x67,
-	0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x43, 0x69, 0x74, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x47,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12,
-	0x20, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x22, 0x28, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x73,
+	0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0b, 0x49, 0x73, 0x53,
+	0x75, 0x70, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x69, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x23, 0x0a,
+	0x0c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x56, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x69, 0x73, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25,
+	0x0a, 0x0d, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x41, 0x67, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x43, 0x69, 0x74, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x47, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x22, 0x7b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5f,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x28, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x08, 0x4c, 0x69, 0x6b,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x07, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x12, 0x22, 0x0a, 0x0b, 0x49, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x5f,
+	0x6c, 0x69, 0x6b, 0x65, 0x22, 0x5f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73,
 	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x32, 0x82, 0x01, 0x0a, 0x08,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x73, 0x12, 0x0f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x42, 0x0e, 0x5a, 0x0c, 0x66, 0x6c, 0x61, 0x6d, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x05,
+	0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xaa, 0x01,
+	0x0a, 0x08, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x14,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x73, 0x12, 0x0f, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x26, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x0c,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x42, 0x0e, 0x5a, 0x0c, 0x66, 0x6c,
+	0x61, 0x6d, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 })
 
 var (
@@ -309,27 +575,34 @@ func file_matching_proto_rawDescGZIP() []byte {
 	return file_matching_proto_rawDescData
 }
 
-var file_matching_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_matching_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_matching_proto_goTypes = []any{
 	(*UserMatch)(nil),           // 0: UserMatch
 	(*GetMatchingUsersReq)(nil), // 1: GetMatchingUsersReq
 	(*GetMatchingUsersRes)(nil), // 2: GetMatchingUsersRes
 	(*UpdateRedisReq)(nil),      // 3: UpdateRedisReq
-	(*UserPhoto)(nil),           // 4: UserPhoto
-	(*emptypb.Empty)(nil),       // 5: google.protobuf.Empty
+	(*LikeCard)(nil),            // 4: LikeCard
+	(*GetLikesReq)(nil),         // 5: GetLikesReq
+	(*GetLikesRes)(nil),         // 6: GetLikesRes
+	(*UserPhoto)(nil),           // 7: UserPhoto
+	(*emptypb.Empty)(nil),       // 8: google.protobuf.Empty
 }
 var file_matching_proto_depIdxs = []int32{
-	4, // 0: UserMatch.Photo:type_name -> UserPhoto
+	7, // 0: UserMatch.Photo:type_name -> UserPhoto
 	0, // 1: GetMatchingUsersRes.users:type_name -> UserMatch
-	1, // 2: Matching.GetMatchingUsers:input_type -> GetMatchingUsersReq
-	3, // 3: Matching.UpdateRedis:input_type -> UpdateRedisReq
-	2, // 4: Matching.GetMatchingUsers:output_type -> GetMatchingUsersRes
-	5, // 5: Matching.UpdateRedis:output_type -> google.protobuf.Empty
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 2: LikeCard.User:type_name -> UserMatch
+	4, // 3: GetLikesRes.likes:type_name -> LikeCard
+	1, // 4: Matching.GetMatchingUsers:input_type -> GetMatchingUsersReq
+	3, // 5: Matching.UpdateRedis:input_type -> UpdateRedisReq
+	5, // 6: Matching.GetLikes:input_type -> GetLikesReq
+	2, // 7: Matching.GetMatchingUsers:output_type -> GetMatchingUsersRes
+	8, // 8: Matching.UpdateRedis:output_type -> google.protobuf.Empty
+	6, // 9: Matching.GetLikes:output_type -> GetLikesRes
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_matching_proto_init() }
@@ -345,7 +618,7 @@ func file_matching_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_matching_proto_rawDesc), len(file_matching_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
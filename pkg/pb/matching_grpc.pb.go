@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Matching_GetMatchingUsers_FullMethodName = "/Matching/GetMatchingUsers"
 	Matching_UpdateRedis_FullMethodName      = "/Matching/UpdateRedis"
+	Matching_GetLikes_FullMethodName         = "/Matching/GetLikes"
 )
 
 // MatchingClient is the client API for Matching service.
@@ -30,6 +31,7 @@ const (
 type MatchingClient interface {
 	GetMatchingUsers(ctx context.Context, in *GetMatchingUsersReq, opts ...grpc.CallOption) (*GetMatchingUsersRes, error)
 	UpdateRedis(ctx context.Context, in *UpdateRedisReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetLikes(ctx context.Context, in *GetLikesReq, opts ...grpc.CallOption) (*GetLikesRes, error)
 }
 
 type matchingClient struct {
@@ -60,12 +62,23 @@ func (c *matchingClient) UpdateRedis(ctx context.Context, in *UpdateRedisReq, op
 	return out, nil
 }
 
+func (c *matchingClient) GetLikes(ctx context.Context, in *GetLikesReq, opts ...grpc.CallOption) (*GetLikesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLikesRes)
+	err := c.cc.Invoke(ctx, Matching_GetLikes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchingServer is the server API for Matching service.
 // All implementations must embed UnimplementedMatchingServer
 // for forward compatibility.
 type MatchingServer interface {
 	GetMatchingUsers(context.Context, *GetMatchingUsersReq) (*GetMatchingUsersRes, error)
 	UpdateRedis(context.Context, *UpdateRedisReq) (*emptypb.Empty, error)
+	GetLikes(context.Context, *GetLikesReq) (*GetLikesRes, error)
 	mustEmbedUnimplementedMatchingServer()
 }
 
@@ -82,6 +95,9 @@ func (UnimplementedMatchingServer) GetMatchingUsers(context.Context, *GetMatchin
 func (UnimplementedMatchingServer) UpdateRedis(context.Context, *UpdateRedisReq) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedis not implemented")
 }
+func (UnimplementedMatchingServer) GetLikes(context.Context, *GetLikesReq) (*GetLikesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLikes not implemented")
+}
 func (UnimplementedMatchingServer) mustEmbedUnimplementedMatchingServer() {}
 func (UnimplementedMatchingServer) testEmbeddedByValue()                  {}
 
@@ -139,6 +155,24 @@ func _Matching_UpdateRedis_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Matching_GetLikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLikesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServer).GetLikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Matching_GetLikes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServer).GetLikes(ctx, req.(*GetLikesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Matching_ServiceDesc is the grpc.ServiceDesc for Matching service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +188,10 @@ var Matching_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRedis",
 			Handler:    _Matching_UpdateRedis_Handler,
 		},
+		{
+			MethodName: "GetLikes",
+			Handler:    _Matching_GetLikes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "matching.proto",
@@ -21,18 +21,134 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type CreateOrUpdateSwipeReq struct {
+type SwipeKind int32
+
+const (
+	SwipeKind_SWIPE_KIND_UNSPECIFIED SwipeKind = 0
+	SwipeKind_SWIPE_KIND_DISLIKE     SwipeKind = 1
+	SwipeKind_SWIPE_KIND_LIKE        SwipeKind = 2
+	SwipeKind_SWIPE_KIND_SUPER_LIKE  SwipeKind = 3
+)
+
+// Enum value maps for SwipeKind.
+var (
+	SwipeKind_name = map[int32]string{
+		0: "SWIPE_KIND_UNSPECIFIED",
+		1: "SWIPE_KIND_DISLIKE",
+		2: "SWIPE_KIND_LIKE",
+		3: "SWIPE_KIND_SUPER_LIKE",
+	}
+	SwipeKind_value = map[string]int32{
+		"SWIPE_KIND_UNSPECIFIED": 0,
+		"SWIPE_KIND_DISLIKE":     1,
+		"SWIPE_KIND_LIKE":        2,
+		"SWIPE_KIND_SUPER_LIKE":  3,
+	}
+)
+
+func (x SwipeKind) Enum() *SwipeKind {
+	p := new(SwipeKind)
+	*p = x
+	return p
+}
+
+func (x SwipeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SwipeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_swipes_proto_enumTypes[0].Descriptor()
+}
+
+func (SwipeKind) Type() protoreflect.EnumType {
+	return &file_swipes_proto_enumTypes[0]
+}
+
+func (x SwipeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SwipeKind.Descriptor instead.
+func (SwipeKind) EnumDescriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{0}
+}
+
+type Match struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId1       int64                  `protobuf:"varint,1,opt,name=UserId1,proto3" json:"UserId1,omitempty"`
-	UserId2       int64                  `protobuf:"varint,2,opt,name=UserId2,proto3" json:"UserId2,omitempty"`
-	IsLike        bool                   `protobuf:"varint,3,opt,name=IsLike,proto3" json:"IsLike,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,json=id,proto3" json:"Id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=UserId,json=user_id,proto3" json:"UserId,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=CreatedAt,json=created_at,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_swipes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Match) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Match) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Match) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateOrUpdateSwipeReq struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId1 int64                  `protobuf:"varint,1,opt,name=UserId1,proto3" json:"UserId1,omitempty"`
+	UserId2 int64                  `protobuf:"varint,2,opt,name=UserId2,proto3" json:"UserId2,omitempty"`
+	// IsLike is read only when Kind is not set, until all clients send Kind.
+	//
+	// Deprecated: Marked as deprecated in swipes.proto.
+	IsLike        *bool     `protobuf:"varint,3,opt,name=IsLike,proto3,oneof" json:"IsLike,omitempty"`
+	Kind          SwipeKind `protobuf:"varint,4,opt,name=Kind,proto3,enum=SwipeKind" json:"Kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateOrUpdateSwipeReq) Reset() {
 	*x = CreateOrUpdateSwipeReq{}
-	mi := &file_swipes_proto_msgTypes[0]
+	mi := &file_swipes_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +160,7 @@ func (x *CreateOrUpdateSwipeReq) String() string {
 func (*CreateOrUpdateSwipeReq) ProtoMessage() {}
 
 func (x *CreateOrUpdateSwipeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_swipes_proto_msgTypes[0]
+	mi := &file_swipes_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +173,7 @@ func (x *CreateOrUpdateSwipeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrUpdateSwipeReq.ProtoReflect.Descriptor instead.
 func (*CreateOrUpdateSwipeReq) Descriptor() ([]byte, []int) {
-	return file_swipes_proto_rawDescGZIP(), []int{0}
+	return file_swipes_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CreateOrUpdateSwipeReq) GetUserId1() int64 {
@@ -74,22 +190,32 @@ func (x *CreateOrUpdateSwipeReq) GetUserId2() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in swipes.proto.
 func (x *CreateOrUpdateSwipeReq) GetIsLike() bool {
-	if x != nil {
-		return x.IsLike
+	if x != nil && x.IsLike != nil {
+		return *x.IsLike
 	}
 	return false
 }
 
+func (x *CreateOrUpdateSwipeReq) GetKind() SwipeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SwipeKind_SWIPE_KIND_UNSPECIFIED
+}
+
 type CreateOrUpdateSwipeRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsMatch       bool                   `protobuf:"varint,1,opt,name=IsMatch,json=is_match,proto3" json:"IsMatch,omitempty"`
+	MatchId       *int64                 `protobuf:"varint,2,opt,name=MatchId,json=match_id,proto3,oneof" json:"MatchId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateOrUpdateSwipeRes) Reset() {
 	*x = CreateOrUpdateSwipeRes{}
-	mi := &file_swipes_proto_msgTypes[1]
+	mi := &file_swipes_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -101,7 +227,7 @@ func (x *CreateOrUpdateSwipeRes) String() string {
 func (*CreateOrUpdateSwipeRes) ProtoMessage() {}
 
 func (x *CreateOrUpdateSwipeRes) ProtoReflect() protoreflect.Message {
-	mi := &file_swipes_proto_msgTypes[1]
+	mi := &file_swipes_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,7 +240,21 @@ func (x *CreateOrUpdateSwipeRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrUpdateSwipeRes.ProtoReflect.Descriptor instead.
 func (*CreateOrUpdateSwipeRes) Descriptor() ([]byte, []int) {
-	return file_swipes_proto_rawDescGZIP(), []int{1}
+	return file_swipes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateOrUpdateSwipeRes) GetIsMatch() bool {
+	if x != nil {
+		return x.IsMatch
+	}
+	return false
+}
+
+func (x *CreateOrUpdateSwipeRes) GetMatchId() int64 {
+	if x != nil && x.MatchId != nil {
+		return *x.MatchId
+	}
+	return 0
 }
 
 type GetUnreadSwipesReq struct {
@@ -126,7 +266,7 @@ type GetUnreadSwipesReq struct {
 
 func (x *GetUnreadSwipesReq) Reset() {
 	*x = GetUnreadSwipesReq{}
-	mi := &file_swipes_proto_msgTypes[2]
+	mi := &file_swipes_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +278,7 @@ func (x *GetUnreadSwipesReq) String() string {
 func (*GetUnreadSwipesReq) ProtoMessage() {}
 
 func (x *GetUnreadSwipesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_swipes_proto_msgTypes[2]
+	mi := &file_swipes_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,7 +291,7 @@ func (x *GetUnreadSwipesReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadSwipesReq.ProtoReflect.Descriptor instead.
 func (*GetUnreadSwipesReq) Descriptor() ([]byte, []int) {
-	return file_swipes_proto_rawDescGZIP(), []int{2}
+	return file_swipes_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetUnreadSwipesReq) GetUserId() int64 {
@@ -164,13 +304,14 @@ func (x *GetUnreadSwipesReq) GetUserId() int64 {
 type GetUnreadSwipesRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=UserIds,proto3" json:"UserIds,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUnreadSwipesRes) Reset() {
 	*x = GetUnreadSwipesRes{}
-	mi := &file_swipes_proto_msgTypes[3]
+	mi := &file_swipes_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -182,7 +323,7 @@ func (x *GetUnreadSwipesRes) String() string {
 func (*GetUnreadSwipesRes) ProtoMessage() {}
 
 func (x *GetUnreadSwipesRes) ProtoReflect() protoreflect.Message {
-	mi := &file_swipes_proto_msgTypes[3]
+	mi := &file_swipes_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,7 +336,7 @@ func (x *GetUnreadSwipesRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadSwipesRes.ProtoReflect.Descriptor instead.
 func (*GetUnreadSwipesRes) Descriptor() ([]byte, []int) {
-	return file_swipes_proto_rawDescGZIP(), []int{3}
+	return file_swipes_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetUnreadSwipesRes) GetUserIds() []int64 {
@@ -205,35 +346,947 @@ func (x *GetUnreadSwipesRes) GetUserIds() []int64 {
 	return nil
 }
 
+func (x *GetUnreadSwipesRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CountUnreadSwipesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountUnreadSwipesReq) Reset() {
+	*x = CountUnreadSwipesReq{}
+	mi := &file_swipes_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountUnreadSwipesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUnreadSwipesReq) ProtoMessage() {}
+
+func (x *CountUnreadSwipesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUnreadSwipesReq.ProtoReflect.Descriptor instead.
+func (*CountUnreadSwipesReq) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CountUnreadSwipesReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CountUnreadSwipesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountUnreadSwipesRes) Reset() {
+	*x = CountUnreadSwipesRes{}
+	mi := &file_swipes_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountUnreadSwipesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountUnreadSwipesRes) ProtoMessage() {}
+
+func (x *CountUnreadSwipesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountUnreadSwipesRes.ProtoReflect.Descriptor instead.
+func (*CountUnreadSwipesRes) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CountUnreadSwipesRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetMatchesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchesReq) Reset() {
+	*x = GetMatchesReq{}
+	mi := &file_swipes_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchesReq) ProtoMessage() {}
+
+func (x *GetMatchesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchesReq.ProtoReflect.Descriptor instead.
+func (*GetMatchesReq) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMatchesReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetMatchesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchesRes) Reset() {
+	*x = GetMatchesRes{}
+	mi := &file_swipes_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchesRes) ProtoMessage() {}
+
+func (x *GetMatchesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchesRes.ProtoReflect.Descriptor instead.
+func (*GetMatchesRes) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMatchesRes) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type GetMatchReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	MatchId       int64                  `protobuf:"varint,2,opt,name=MatchId,proto3" json:"MatchId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchReq) Reset() {
+	*x = GetMatchReq{}
+	mi := &file_swipes_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchReq) ProtoMessage() {}
+
+func (x *GetMatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchReq.ProtoReflect.Descriptor instead.
+func (*GetMatchReq) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMatchReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetMatchReq) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+type GetMatchRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchRes) Reset() {
+	*x = GetMatchRes{}
+	mi := &file_swipes_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchRes) ProtoMessage() {}
+
+func (x *GetMatchRes) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchRes.ProtoReflect.Descriptor instead.
+func (*GetMatchRes) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetMatchRes) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type UnmatchReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	MatchId       int64                  `protobuf:"varint,2,opt,name=MatchId,proto3" json:"MatchId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmatchReq) Reset() {
+	*x = UnmatchReq{}
+	mi := &file_swipes_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmatchReq) ProtoMessage() {}
+
+func (x *UnmatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmatchReq.ProtoReflect.Descriptor instead.
+func (*UnmatchReq) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UnmatchReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnmatchReq) GetMatchId() int64 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+type UnmatchRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,json=user_id,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmatchRes) Reset() {
+	*x = UnmatchRes{}
+	mi := &file_swipes_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmatchRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmatchRes) ProtoMessage() {}
+
+func (x *UnmatchRes) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmatchRes.ProtoReflect.Descriptor instead.
+func (*UnmatchRes) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnmatchRes) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type IsMatchedReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId1       int64                  `protobuf:"varint,1,opt,name=UserId1,proto3" json:"UserId1,omitempty"`
+	UserId2       int64                  `protobuf:"varint,2,opt,name=UserId2,proto3" json:"UserId2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsMatchedReq) Reset() {
+	*x = IsMatchedReq{}
+	mi := &file_swipes_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsMatchedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMatchedReq) ProtoMessage() {}
+
+func (x *IsMatchedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMatchedReq.ProtoReflect.Descriptor instead.
+func (*IsMatchedReq) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *IsMatchedReq) GetUserId1() int64 {
+	if x != nil {
+		return x.UserId1
+	}
+	return 0
+}
+
+func (x *IsMatchedReq) GetUserId2() int64 {
+	if x != nil {
+		return x.UserId2
+	}
+	return 0
+}
+
+type IsMatchedRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsMatched     bool                   `protobuf:"varint,1,opt,name=IsMatched,proto3" json:"IsMatched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsMatchedRes) Reset() {
+	*x = IsMatchedRes{}
+	mi := &file_swipes_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsMatchedRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMatchedRes) ProtoMessage() {}
+
+func (x *IsMatchedRes) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMatchedRes.ProtoReflect.Descriptor instead.
+func (*IsMatchedRes) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IsMatchedRes) GetIsMatched() bool {
+	if x != nil {
+		return x.IsMatched
+	}
+	return false
+}
+
+type RewindReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewindReq) Reset() {
+	*x = RewindReq{}
+	mi := &file_swipes_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewindReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewindReq) ProtoMessage() {}
+
+func (x *RewindReq) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewindReq.ProtoReflect.Descriptor instead.
+func (*RewindReq) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RewindReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RewindRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,json=user_id,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RewindRes) Reset() {
+	*x = RewindRes{}
+	mi := &file_swipes_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RewindRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewindRes) ProtoMessage() {}
+
+func (x *RewindRes) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewindRes.ProtoReflect.Descriptor instead.
+func (*RewindRes) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RewindRes) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Quota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int64                  `protobuf:"varint,1,opt,name=Limit,json=limit,proto3" json:"Limit,omitempty"`
+	Used          int64                  `protobuf:"varint,2,opt,name=Used,json=used,proto3" json:"Used,omitempty"`
+	Remaining     int64                  `protobuf:"varint,3,opt,name=Remaining,json=remaining,proto3" json:"Remaining,omitempty"`
+	ResetAt       *string                `protobuf:"bytes,4,opt,name=ResetAt,json=reset_at,proto3,oneof" json:"ResetAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_swipes_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Quota) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Quota) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *Quota) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *Quota) GetResetAt() string {
+	if x != nil && x.ResetAt != nil {
+		return *x.ResetAt
+	}
+	return ""
+}
+
+type GetQuotaReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaReq) Reset() {
+	*x = GetQuotaReq{}
+	mi := &file_swipes_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaReq) ProtoMessage() {}
+
+func (x *GetQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaReq.ProtoReflect.Descriptor instead.
+func (*GetQuotaReq) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetQuotaReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetQuotaRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Likes         *Quota                 `protobuf:"bytes,1,opt,name=Likes,json=likes,proto3" json:"Likes,omitempty"`
+	SuperLikes    *Quota                 `protobuf:"bytes,2,opt,name=SuperLikes,json=super_likes,proto3" json:"SuperLikes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaRes) Reset() {
+	*x = GetQuotaRes{}
+	mi := &file_swipes_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaRes) ProtoMessage() {}
+
+func (x *GetQuotaRes) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaRes.ProtoReflect.Descriptor instead.
+func (*GetQuotaRes) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetQuotaRes) GetLikes() *Quota {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *GetQuotaRes) GetSuperLikes() *Quota {
+	if x != nil {
+		return x.SuperLikes
+	}
+	return nil
+}
+
+type BlockPairReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=TargetId,proto3" json:"TargetId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockPairReq) Reset() {
+	*x = BlockPairReq{}
+	mi := &file_swipes_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockPairReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockPairReq) ProtoMessage() {}
+
+func (x *BlockPairReq) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockPairReq.ProtoReflect.Descriptor instead.
+func (*BlockPairReq) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BlockPairReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BlockPairReq) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type BlockPairRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockPairRes) Reset() {
+	*x = BlockPairRes{}
+	mi := &file_swipes_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockPairRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockPairRes) ProtoMessage() {}
+
+func (x *BlockPairRes) ProtoReflect() protoreflect.Message {
+	mi := &file_swipes_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockPairRes.ProtoReflect.Descriptor instead.
+func (*BlockPairRes) Descriptor() ([]byte, []int) {
+	return file_swipes_proto_rawDescGZIP(), []int{21}
+}
+
 var File_swipes_proto protoreflect.FileDescriptor
 
 var file_swipes_proto_rawDesc = string([]byte{
-	0x0a, 0x0c, 0x73, 0x77, 0x69, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x64,
-	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x77, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x31, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x32, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x32, 0x12, 0x16, 0x0a, 0x06,
-	0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73,
-	0x4c, 0x69, 0x6b, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x22, 0x2c,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69, 0x70, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69, 0x70, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x32, 0x8e, 0x01, 0x0a,
-	0x06, 0x53, 0x77, 0x69, 0x70, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x70, 0x65, 0x12, 0x17,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x77, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73,
-	0x12, 0x3b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69,
-	0x70, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53,
-	0x77, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e,
-	0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x42, 0x0e, 0x5a,
-	0x0c, 0x66, 0x6c, 0x61, 0x6d, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x0c, 0x73, 0x77, 0x69, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4f,
+	0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x12, 0x1d, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22,
+	0x98, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x77, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x31, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x32, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x32, 0x12, 0x1f,
+	0x0a, 0x06, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02,
+	0x18, 0x01, 0x48, 0x00, 0x52, 0x06, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1e, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e,
+	0x53, 0x77, 0x69, 0x70, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x49, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x22, 0x5f, 0x0a, 0x16, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x07, 0x49, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x1e, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x2e, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77,
+	0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x2c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77,
+	0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x27, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x3f, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x3e, 0x0a, 0x0a, 0x55, 0x6e, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x22, 0x25, 0x0a, 0x0a, 0x55, 0x6e, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x42,
+	0x0a, 0x0c, 0x49, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x31, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x32, 0x22, 0x2c, 0x0a, 0x0c, 0x49, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x22, 0x23, 0x0a, 0x09, 0x52, 0x65, 0x77, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x09, 0x52, 0x65, 0x77, 0x69, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x7b, 0x0a, 0x05, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x07,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74, 0x22, 0x25, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x54, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0a,
+	0x53, 0x75, 0x70, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x0b, 0x73, 0x75, 0x70, 0x65, 0x72, 0x5f,
+	0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x2a, 0x6f, 0x0a, 0x09, 0x53, 0x77, 0x69,
+	0x70, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x44, 0x49, 0x53, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x57,
+	0x49, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x02, 0x12,
+	0x19, 0x0a, 0x15, 0x53, 0x57, 0x49, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x55,
+	0x50, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x10, 0x03, 0x32, 0xec, 0x03, 0x0a, 0x06, 0x53,
+	0x77, 0x69, 0x70, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x70, 0x65, 0x12, 0x17, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69,
+	0x70, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x12, 0x3b,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69, 0x70, 0x65,
+	0x73, 0x12, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69,
+	0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x53, 0x77, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x11, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69, 0x70, 0x65, 0x73,
+	0x12, 0x15, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77,
+	0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x53, 0x77, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x0e, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x07, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x0b, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x55,
+	0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x49, 0x73, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x0d, 0x2e, 0x49, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x49, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x69, 0x6e, 0x64, 0x12, 0x0a,
+	0x2e, 0x52, 0x65, 0x77, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0a, 0x2e, 0x52, 0x65, 0x77,
+	0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x12, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x1a, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x61, 0x69, 0x72, 0x12, 0x0d, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x42, 0x0e, 0x5a, 0x0c, 0x66, 0x6c, 0x61,
+	0x6d, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 })
 
 var (
@@ -248,23 +1301,64 @@ func file_swipes_proto_rawDescGZIP() []byte {
 	return file_swipes_proto_rawDescData
 }
 
-var file_swipes_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_swipes_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_swipes_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_swipes_proto_goTypes = []any{
-	(*CreateOrUpdateSwipeReq)(nil), // 0: CreateOrUpdateSwipeReq
-	(*CreateOrUpdateSwipeRes)(nil), // 1: CreateOrUpdateSwipeRes
-	(*GetUnreadSwipesReq)(nil),     // 2: GetUnreadSwipesReq
-	(*GetUnreadSwipesRes)(nil),     // 3: GetUnreadSwipesRes
+	(SwipeKind)(0),                 // 0: SwipeKind
+	(*Match)(nil),                  // 1: Match
+	(*CreateOrUpdateSwipeReq)(nil), // 2: CreateOrUpdateSwipeReq
+	(*CreateOrUpdateSwipeRes)(nil), // 3: CreateOrUpdateSwipeRes
+	(*GetUnreadSwipesReq)(nil),     // 4: GetUnreadSwipesReq
+	(*GetUnreadSwipesRes)(nil),     // 5: GetUnreadSwipesRes
+	(*CountUnreadSwipesReq)(nil),   // 6: CountUnreadSwipesReq
+	(*CountUnreadSwipesRes)(nil),   // 7: CountUnreadSwipesRes
+	(*GetMatchesReq)(nil),          // 8: GetMatchesReq
+	(*GetMatchesRes)(nil),          // 9: GetMatchesRes
+	(*GetMatchReq)(nil),            // 10: GetMatchReq
+	(*GetMatchRes)(nil),            // 11: GetMatchRes
+	(*UnmatchReq)(nil),             // 12: UnmatchReq
+	(*UnmatchRes)(nil),             // 13: UnmatchRes
+	(*IsMatchedReq)(nil),           // 14: IsMatchedReq
+	(*IsMatchedRes)(nil),           // 15: IsMatchedRes
+	(*RewindReq)(nil),              // 16: RewindReq
+	(*RewindRes)(nil),              // 17: RewindRes
+	(*Quota)(nil),                  // 18: Quota
+	(*GetQuotaReq)(nil),            // 19: GetQuotaReq
+	(*GetQuotaRes)(nil),            // 20: GetQuotaRes
+	(*BlockPairReq)(nil),           // 21: BlockPairReq
+	(*BlockPairRes)(nil),           // 22: BlockPairRes
 }
 var file_swipes_proto_depIdxs = []int32{
-	0, // 0: Swipes.CreateOrUpdateSwipe:input_type -> CreateOrUpdateSwipeReq
-	2, // 1: Swipes.GetUnreadSwipes:input_type -> GetUnreadSwipesReq
-	1, // 2: Swipes.CreateOrUpdateSwipe:output_type -> CreateOrUpdateSwipeRes
-	3, // 3: Swipes.GetUnreadSwipes:output_type -> GetUnreadSwipesRes
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: CreateOrUpdateSwipeReq.Kind:type_name -> SwipeKind
+	1,  // 1: GetMatchesRes.matches:type_name -> Match
+	1,  // 2: GetMatchRes.match:type_name -> Match
+	18, // 3: GetQuotaRes.Likes:type_name -> Quota
+	18, // 4: GetQuotaRes.SuperLikes:type_name -> Quota
+	2,  // 5: Swipes.CreateOrUpdateSwipe:input_type -> CreateOrUpdateSwipeReq
+	4,  // 6: Swipes.GetUnreadSwipes:input_type -> GetUnreadSwipesReq
+	6,  // 7: Swipes.CountUnreadSwipes:input_type -> CountUnreadSwipesReq
+	8,  // 8: Swipes.GetMatches:input_type -> GetMatchesReq
+	10, // 9: Swipes.GetMatch:input_type -> GetMatchReq
+	12, // 10: Swipes.Unmatch:input_type -> UnmatchReq
+	14, // 11: Swipes.IsMatched:input_type -> IsMatchedReq
+	16, // 12: Swipes.Rewind:input_type -> RewindReq
+	19, // 13: Swipes.GetQuota:input_type -> GetQuotaReq
+	21, // 14: Swipes.BlockPair:input_type -> BlockPairReq
+	3,  // 15: Swipes.CreateOrUpdateSwipe:output_type -> CreateOrUpdateSwipeRes
+	5,  // 16: Swipes.GetUnreadSwipes:output_type -> GetUnreadSwipesRes
+	7,  // 17: Swipes.CountUnreadSwipes:output_type -> CountUnreadSwipesRes
+	9,  // 18: Swipes.GetMatches:output_type -> GetMatchesRes
+	11, // 19: Swipes.GetMatch:output_type -> GetMatchRes
+	13, // 20: Swipes.Unmatch:output_type -> UnmatchRes
+	15, // 21: Swipes.IsMatched:output_type -> IsMatchedRes
+	17, // 22: Swipes.Rewind:output_type -> RewindRes
+	20, // 23: Swipes.GetQuota:output_type -> GetQuotaRes
+	22, // 24: Swipes.BlockPair:output_type -> BlockPairRes
+	15, // [15:25] is the sub-list for method output_type
+	5,  // [5:15] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_swipes_proto_init() }
@@ -272,18 +1366,22 @@ func file_swipes_proto_init() {
 	if File_swipes_proto != nil {
 		return
 	}
+	file_swipes_proto_msgTypes[1].OneofWrappers = []any{}
+	file_swipes_proto_msgTypes[2].OneofWrappers = []any{}
+	file_swipes_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_swipes_proto_rawDesc), len(file_swipes_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_swipes_proto_goTypes,
 		DependencyIndexes: file_swipes_proto_depIdxs,
+		EnumInfos:         file_swipes_proto_enumTypes,
 		MessageInfos:      file_swipes_proto_msgTypes,
 	}.Build()
 	File_swipes_proto = out.File
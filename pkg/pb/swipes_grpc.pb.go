@@ -21,6 +21,14 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	Swipes_CreateOrUpdateSwipe_FullMethodName = "/Swipes/CreateOrUpdateSwipe"
 	Swipes_GetUnreadSwipes_FullMethodName     = "/Swipes/GetUnreadSwipes"
+	Swipes_CountUnreadSwipes_FullMethodName   = "/Swipes/CountUnreadSwipes"
+	Swipes_GetMatches_FullMethodName          = "/Swipes/GetMatches"
+	Swipes_GetMatch_FullMethodName            = "/Swipes/GetMatch"
+	Swipes_Unmatch_FullMethodName             = "/Swipes/Unmatch"
+	Swipes_IsMatched_FullMethodName           = "/Swipes/IsMatched"
+	Swipes_Rewind_FullMethodName              = "/Swipes/Rewind"
+	Swipes_GetQuota_FullMethodName            = "/Swipes/GetQuota"
+	Swipes_BlockPair_FullMethodName           = "/Swipes/BlockPair"
 )
 
 // SwipesClient is the client API for Swipes service.
@@ -29,6 +37,14 @@ const (
 type SwipesClient interface {
 	CreateOrUpdateSwipe(ctx context.Context, in *CreateOrUpdateSwipeReq, opts ...grpc.CallOption) (*CreateOrUpdateSwipeRes, error)
 	GetUnreadSwipes(ctx context.Context, in *GetUnreadSwipesReq, opts ...grpc.CallOption) (*GetUnreadSwipesRes, error)
+	CountUnreadSwipes(ctx context.Context, in *CountUnreadSwipesReq, opts ...grpc.CallOption) (*CountUnreadSwipesRes, error)
+	GetMatches(ctx context.Context, in *GetMatchesReq, opts ...grpc.CallOption) (*GetMatchesRes, error)
+	GetMatch(ctx context.Context, in *GetMatchReq, opts ...grpc.CallOption) (*GetMatchRes, error)
+	Unmatch(ctx context.Context, in *UnmatchReq, opts ...grpc.CallOption) (*UnmatchRes, error)
+	IsMatched(ctx context.Context, in *IsMatchedReq, opts ...grpc.CallOption) (*IsMatchedRes, error)
+	Rewind(ctx context.Context, in *RewindReq, opts ...grpc.CallOption) (*RewindRes, error)
+	GetQuota(ctx context.Context, in *GetQuotaReq, opts ...grpc.CallOption) (*GetQuotaRes, error)
+	BlockPair(ctx context.Context, in *BlockPairReq, opts ...grpc.CallOption) (*BlockPairRes, error)
 }
 
 type swipesClient struct {
@@ -59,12 +75,100 @@ func (c *swipesClient) GetUnreadSwipes(ctx context.Context, in *GetUnreadSwipesR
 	return out, nil
 }
 
+func (c *swipesClient) CountUnreadSwipes(ctx context.Context, in *CountUnreadSwipesReq, opts ...grpc.CallOption) (*CountUnreadSwipesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountUnreadSwipesRes)
+	err := c.cc.Invoke(ctx, Swipes_CountUnreadSwipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swipesClient) GetMatches(ctx context.Context, in *GetMatchesReq, opts ...grpc.CallOption) (*GetMatchesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMatchesRes)
+	err := c.cc.Invoke(ctx, Swipes_GetMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swipesClient) GetMatch(ctx context.Context, in *GetMatchReq, opts ...grpc.CallOption) (*GetMatchRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMatchRes)
+	err := c.cc.Invoke(ctx, Swipes_GetMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swipesClient) Unmatch(ctx context.Context, in *UnmatchReq, opts ...grpc.CallOption) (*UnmatchRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmatchRes)
+	err := c.cc.Invoke(ctx, Swipes_Unmatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swipesClient) IsMatched(ctx context.Context, in *IsMatchedReq, opts ...grpc.CallOption) (*IsMatchedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsMatchedRes)
+	err := c.cc.Invoke(ctx, Swipes_IsMatched_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swipesClient) Rewind(ctx context.Context, in *RewindReq, opts ...grpc.CallOption) (*RewindRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RewindRes)
+	err := c.cc.Invoke(ctx, Swipes_Rewind_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swipesClient) GetQuota(ctx context.Context, in *GetQuotaReq, opts ...grpc.CallOption) (*GetQuotaRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuotaRes)
+	err := c.cc.Invoke(ctx, Swipes_GetQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swipesClient) BlockPair(ctx context.Context, in *BlockPairReq, opts ...grpc.CallOption) (*BlockPairRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockPairRes)
+	err := c.cc.Invoke(ctx, Swipes_BlockPair_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SwipesServer is the server API for Swipes service.
 // All implementations must embed UnimplementedSwipesServer
 // for forward compatibility.
 type SwipesServer interface {
 	CreateOrUpdateSwipe(context.Context, *CreateOrUpdateSwipeReq) (*CreateOrUpdateSwipeRes, error)
 	GetUnreadSwipes(context.Context, *GetUnreadSwipesReq) (*GetUnreadSwipesRes, error)
+	CountUnreadSwipes(context.Context, *CountUnreadSwipesReq) (*CountUnreadSwipesRes, error)
+	GetMatches(context.Context, *GetMatchesReq) (*GetMatchesRes, error)
+	GetMatch(context.Context, *GetMatchReq) (*GetMatchRes, error)
+	Unmatch(context.Context, *UnmatchReq) (*UnmatchRes, error)
+	IsMatched(context.Context, *IsMatchedReq) (*IsMatchedRes, error)
+	Rewind(context.Context, *RewindReq) (*RewindRes, error)
+	GetQuota(context.Context, *GetQuotaReq) (*GetQuotaRes, error)
+	BlockPair(context.Context, *BlockPairReq) (*BlockPairRes, error)
 	mustEmbedUnimplementedSwipesServer()
 }
 
@@ -81,6 +185,30 @@ func (UnimplementedSwipesServer) CreateOrUpdateSwipe(context.Context, *CreateOrU
 func (UnimplementedSwipesServer) GetUnreadSwipes(context.Context, *GetUnreadSwipesReq) (*GetUnreadSwipesRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadSwipes not implemented")
 }
+func (UnimplementedSwipesServer) CountUnreadSwipes(context.Context, *CountUnreadSwipesReq) (*CountUnreadSwipesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountUnreadSwipes not implemented")
+}
+func (UnimplementedSwipesServer) GetMatches(context.Context, *GetMatchesReq) (*GetMatchesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatches not implemented")
+}
+func (UnimplementedSwipesServer) GetMatch(context.Context, *GetMatchReq) (*GetMatchRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatch not implemented")
+}
+func (UnimplementedSwipesServer) Unmatch(context.Context, *UnmatchReq) (*UnmatchRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmatch not implemented")
+}
+func (UnimplementedSwipesServer) IsMatched(context.Context, *IsMatchedReq) (*IsMatchedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsMatched not implemented")
+}
+func (UnimplementedSwipesServer) Rewind(context.Context, *RewindReq) (*RewindRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rewind not implemented")
+}
+func (UnimplementedSwipesServer) GetQuota(context.Context, *GetQuotaReq) (*GetQuotaRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedSwipesServer) BlockPair(context.Context, *BlockPairReq) (*BlockPairRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockPair not implemented")
+}
 func (UnimplementedSwipesServer) mustEmbedUnimplementedSwipesServer() {}
 func (UnimplementedSwipesServer) testEmbeddedByValue()                {}
 
@@ -138,6 +266,150 @@ func _Swipes_GetUnreadSwipes_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Swipes_CountUnreadSwipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountUnreadSwipesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwipesServer).CountUnreadSwipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Swipes_CountUnreadSwipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwipesServer).CountUnreadSwipes(ctx, req.(*CountUnreadSwipesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Swipes_GetMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwipesServer).GetMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Swipes_GetMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwipesServer).GetMatches(ctx, req.(*GetMatchesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Swipes_GetMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwipesServer).GetMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Swipes_GetMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwipesServer).GetMatch(ctx, req.(*GetMatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Swipes_Unmatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwipesServer).Unmatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Swipes_Unmatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwipesServer).Unmatch(ctx, req.(*UnmatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Swipes_IsMatched_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsMatchedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwipesServer).IsMatched(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Swipes_IsMatched_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwipesServer).IsMatched(ctx, req.(*IsMatchedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Swipes_Rewind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewindReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwipesServer).Rewind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Swipes_Rewind_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwipesServer).Rewind(ctx, req.(*RewindReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Swipes_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwipesServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Swipes_GetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwipesServer).GetQuota(ctx, req.(*GetQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Swipes_BlockPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockPairReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwipesServer).BlockPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Swipes_BlockPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwipesServer).BlockPair(ctx, req.(*BlockPairReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Swipes_ServiceDesc is the grpc.ServiceDesc for Swipes service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +425,38 @@ var Swipes_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUnreadSwipes",
 			Handler:    _Swipes_GetUnreadSwipes_Handler,
 		},
+		{
+			MethodName: "CountUnreadSwipes",
+			Handler:    _Swipes_CountUnreadSwipes_Handler,
+		},
+		{
+			MethodName: "GetMatches",
+			Handler:    _Swipes_GetMatches_Handler,
+		},
+		{
+			MethodName: "GetMatch",
+			Handler:    _Swipes_GetMatch_Handler,
+		},
+		{
+			MethodName: "Unmatch",
+			Handler:    _Swipes_Unmatch_Handler,
+		},
+		{
+			MethodName: "IsMatched",
+			Handler:    _Swipes_IsMatched_Handler,
+		},
+		{
+			MethodName: "Rewind",
+			Handler:    _Swipes_Rewind_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _Swipes_GetQuota_Handler,
+		},
+		{
+			MethodName: "BlockPair",
+			Handler:    _Swipes_BlockPair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "swipes.proto",
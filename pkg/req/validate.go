@@ -1,8 +1,106 @@
 package req
 
-import "github.com/go-playground/validator/v10"
+import (
+	"context"
+	"errors"
+	"flame/pkg/geo"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	// Errors name fields as the client sends them.
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+	// A geo.Location is validated as the raw string, so "required" and "omitempty" work on it.
+	v.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		return field.Interface().(geo.Location).Raw
+	}, geo.Location{})
+	v.RegisterValidationCtx("location", func(ctx context.Context, fl validator.FieldLevel) bool {
+		_, err := geo.Parse(fl.Field().String())
+		if err != nil {
+			if causes, ok := ctx.Value(causesKey{}).(map[string]error); ok {
+				causes[fl.FieldName()] = err
+			}
+		}
+		return err == nil
+	})
+	return v
+}
+
+// causesKey holds in the validation context the map the checks that parse a value write their
+// error to, so the message does not have to parse it again.
+type causesKey struct{}
+
+// ValidationError maps the invalid fields of a request body to what is wrong with them.
+// Causes holds the error behind a message when a check produced one, like the geo error of a
+// location.
+type ValidationError struct {
+	Fields map[string]string
+	Causes map[string]error
+}
+
+func (e *ValidationError) Error() string {
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	messages := make([]string, len(names))
+	for i, name := range names {
+		messages[i] = name + ": " + e.Fields[name]
+	}
+	return strings.Join(messages, "; ")
+}
+
+// FieldErrors returns the field-level messages of err, or nil if it is not a ValidationError.
+func FieldErrors(err error) map[string]string {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr.Fields
+	}
+	return nil
+}
 
 func IsValid[T any](payload T) error {
-	err := validator.New().Struct(payload)
-	return err
+	causes := make(map[string]error)
+	err := validate.StructCtx(context.WithValue(context.Background(), causesKey{}, causes), payload)
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+	fields := make(map[string]string, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		fields[fieldErr.Field()] = message(fieldErr, causes[fieldErr.Field()])
+	}
+	return &ValidationError{Fields: fields, Causes: causes}
+}
+
+func message(fieldErr validator.FieldError, cause error) string {
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email"
+	case "min":
+		return fmt.Sprintf("must be at least %s", fieldErr.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s", fieldErr.Param())
+	case "location":
+		if cause != nil {
+			return cause.Error()
+		}
+	}
+	return fmt.Sprintf("failed the %q check", fieldErr.Tag())
 }
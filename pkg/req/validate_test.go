@@ -0,0 +1,79 @@
+package req
+
+import (
+	"encoding/json"
+	"errors"
+	"flame/pkg/geo"
+	"github.com/go-playground/assert/v2"
+	"testing"
+)
+
+type locationBody struct {
+	Location geo.Location `json:"location" validate:"required,location"`
+	Home     geo.Location `json:"home_location,omitempty" validate:"omitempty,location"`
+	Name     string       `validate:"omitempty,min=2"`
+}
+
+func TestIsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		fields map[string]string
+		causes map[string]error
+	}{
+		{
+			name: "success",
+			body: `{"location": "(37.6173 55.7558)", "home_location": {"lat": 55.7558, "lon": 37.6173}}`,
+		},
+		{
+			name: "optional location missing",
+			body: `{"location": {"type": "Point", "coordinates": [37.6173, 55.7558]}}`,
+		},
+		{
+			name:   "required location missing",
+			body:   `{"home_location": "(37.6173 55.7558)"}`,
+			fields: map[string]string{"location": "is required"},
+			causes: map[string]error{},
+		},
+		{
+			name:   "required location null",
+			body:   `{"location": null}`,
+			fields: map[string]string{"location": "is required"},
+			causes: map[string]error{},
+		},
+		{
+			name:   "invalid location",
+			body:   `{"location": {"lat": 95, "lon": 37.6173}}`,
+			fields: map[string]string{"location": geo.ErrLatitude.Error()},
+			causes: map[string]error{"location": geo.ErrLatitude},
+		},
+		{
+			name: "invalid optional location and field without a json tag",
+			body: `{"location": "(37.6173 55.7558)", "home_location": "Moscow", "Name": "a"}`,
+			fields: map[string]string{
+				"home_location": geo.ErrFormat.Error(),
+				"Name":          "must be at least 2",
+			},
+			causes: map[string]error{"home_location": geo.ErrFormat},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body locationBody
+			assert.Equal(t, json.Unmarshal([]byte(tt.body), &body), nil)
+			err := IsValid(body)
+			if tt.fields == nil {
+				assert.Equal(t, err, nil)
+				return
+			}
+			var validationErr *ValidationError
+			assert.Equal(t, errors.As(err, &validationErr), true)
+			assert.Equal(t, validationErr.Fields, tt.fields)
+			assert.Equal(t, len(validationErr.Causes), len(tt.causes))
+			for field, cause := range tt.causes {
+				assert.Equal(t, errors.Is(validationErr.Causes[field], cause), true)
+			}
+			assert.Equal(t, FieldErrors(err), tt.fields)
+		})
+	}
+}
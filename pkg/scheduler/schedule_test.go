@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"github.com/go-playground/assert/v2"
+	"testing"
+	"time"
+)
+
+func TestCronNext(t *testing.T) {
+	after := time.Date(2025, time.May, 20, 10, 17, 30, 0, time.UTC)
+	tests := []struct {
+		name string
+		spec string
+		next time.Time
+	}{
+		{
+			name: "every minute",
+			spec: "* * * * *",
+			next: time.Date(2025, time.May, 20, 10, 18, 0, 0, time.UTC),
+		},
+		{
+			name: "step",
+			spec: "*/15 * * * *",
+			next: time.Date(2025, time.May, 20, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "daily",
+			spec: "0 3 * * *",
+			next: time.Date(2025, time.May, 21, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "list and range",
+			spec: "5,45 9-11 * * *",
+			next: time.Date(2025, time.May, 20, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			name: "sunday as 7",
+			spec: "0 0 * * 7",
+			next: time.Date(2025, time.May, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			spec: "0 0 1 * 3",
+			next: time.Date(2025, time.May, 21, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap day",
+			spec: "0 0 29 2 *",
+			next: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Cron(tt.spec)
+			assert.Equal(t, err, nil)
+			assert.Equal(t, s.Next(after), tt.next)
+		})
+	}
+}
+
+func TestCronInvalid(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := Cron(spec)
+		assert.NotEqual(t, err, nil)
+	}
+}
+
+func TestEveryNext(t *testing.T) {
+	after := time.Date(2025, time.May, 20, 10, 17, 30, 0, time.UTC)
+	assert.Equal(t, Every(15*time.Minute).Next(after), time.Date(2025, time.May, 20, 10, 30, 0, 0, time.UTC))
+	assert.Equal(t, Every(time.Hour).Next(after), time.Date(2025, time.May, 20, 11, 0, 0, 0, time.UTC))
+}
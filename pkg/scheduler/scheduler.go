@@ -0,0 +1,194 @@
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"flame/pkg/db"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout = 5 * time.Minute
+	defaultBackoff = 10 * time.Second
+	maxBackoff     = 5 * time.Minute
+)
+
+const (
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+type Job struct {
+	Name     string
+	Schedule Schedule
+	// Timeout limits a single attempt.
+	Timeout time.Duration
+	// Retries is the number of extra attempts after a failure, Backoff the delay before the
+	// first of them, doubled for every next one.
+	Retries int
+	Backoff time.Duration
+	Run     func(ctx context.Context) error
+}
+
+type SchedulerDeps struct {
+	DB       *db.DB
+	Redis    *db.Redis
+	Logger   *slog.Logger
+	Instance string
+}
+
+// Scheduler runs jobs on every replica of the worker, but a run of a job at a given time is
+// executed by only one of them: the replica that takes the Redis lock of that run.
+// Every run is recorded in the job_runs table.
+type Scheduler struct {
+	DB       *db.DB
+	Redis    *db.Redis
+	Logger   *slog.Logger
+	Instance string
+	jobs     []Job
+}
+
+func NewScheduler(deps *SchedulerDeps) *Scheduler {
+	return &Scheduler{
+		DB:       deps.DB,
+		Redis:    deps.Redis,
+		Logger:   deps.Logger,
+		Instance: deps.Instance,
+	}
+}
+
+func (scheduler *Scheduler) Add(jobs ...Job) {
+	for _, job := range jobs {
+		if job.Timeout <= 0 {
+			job.Timeout = defaultTimeout
+		}
+		if job.Backoff <= 0 {
+			job.Backoff = defaultBackoff
+		}
+		scheduler.jobs = append(scheduler.jobs, job)
+	}
+}
+
+// Run blocks until ctx is cancelled and every running job has returned.
+func (scheduler *Scheduler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, job := range scheduler.jobs {
+		wg.Add(1)
+		go func(job Job) {
+			defer wg.Done()
+			scheduler.loop(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+func (scheduler *Scheduler) loop(ctx context.Context, job Job) {
+	for {
+		next := job.Schedule.Next(time.Now().UTC())
+		if next.IsZero() {
+			scheduler.Logger.Error("job has no next run", slog.String("Job", job.Name))
+			return
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		scheduler.runOnce(ctx, job, next)
+	}
+}
+
+func (scheduler *Scheduler) runOnce(ctx context.Context, job Job, scheduledAt time.Time) {
+	token, err := newToken()
+	if err != nil {
+		scheduler.Logger.Error(err.Error(), slog.String("Error location", "scheduler.newToken"))
+		return
+	}
+	lockKey := fmt.Sprintf("scheduler:%s:%d", job.Name, scheduledAt.Unix())
+	// The lock is kept until it expires so that a replica whose clock is behind does not run
+	// the same occurrence again after the winner has finished.
+	lockTTL := time.Duration(job.Retries+1)*job.Timeout + maxBackoff*time.Duration(job.Retries) + time.Minute
+	acquired, err := scheduler.Redis.SetNX(ctx, lockKey, token, lockTTL).Result()
+	if err != nil {
+		scheduler.Logger.Error(err.Error(),
+			slog.String("Error location", "scheduler.Redis.SetNX"),
+			slog.String("Job", job.Name),
+		)
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	startedAt := time.Now().UTC()
+	attempts, err := scheduler.attempt(ctx, job)
+	status := StatusSucceeded
+	var errText *string
+	if err != nil {
+		status = StatusFailed
+		text := err.Error()
+		errText = &text
+		scheduler.Logger.Error(text,
+			slog.String("Error location", "scheduler.attempt"),
+			slog.String("Job", job.Name),
+			slog.Int("Attempts", attempts),
+		)
+	}
+	_, err = scheduler.DB.Exec(`INSERT INTO job_runs (job, instance, scheduled_at, started_at, finished_at, attempts, status, error)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`,
+		job.Name, scheduler.Instance, scheduledAt, startedAt, time.Now().UTC(), attempts, status, errText)
+	if err != nil {
+		scheduler.Logger.Error(err.Error(),
+			slog.String("Error location", "scheduler.DB.Exec"),
+			slog.String("Job", job.Name),
+		)
+	}
+}
+
+func (scheduler *Scheduler) attempt(ctx context.Context, job Job) (int, error) {
+	backoff := job.Backoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = scheduler.call(ctx, job)
+		if err == nil || attempt > job.Retries || ctx.Err() != nil {
+			return attempt, err
+		}
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+func (scheduler *Scheduler) call(ctx context.Context, job Job) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, job.Timeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	err = job.Run(ctx)
+	if err == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = ctx.Err()
+	}
+	return err
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 8)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
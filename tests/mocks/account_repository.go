@@ -3,6 +3,7 @@ package mocks
 import (
 	"flame/internal/models"
 	"github.com/stretchr/testify/mock"
+	"time"
 )
 
 type MockAccountRepository struct {
@@ -27,3 +28,116 @@ func (mock *MockAccountRepository) GetByEmail(email string) *models.User {
 	}
 	return args.Get(0).(*models.User)
 }
+func (mock *MockAccountRepository) UpdateProfile(user *models.User) error {
+	return mock.Called(user).Error(0)
+}
+func (mock *MockAccountRepository) UploadPhoto(userId int64, link string) (*int64, error) {
+	args := mock.Called(userId, link)
+	id, _ := args.Get(0).(*int64)
+	return id, args.Error(1)
+}
+func (mock *MockAccountRepository) SetMainPhoto(userId int64, mainPhotoId int64) error {
+	return mock.Called(userId, mainPhotoId).Error(0)
+}
+func (mock *MockAccountRepository) GetUserProfilePhotos(userId int64) []models.UserPhoto {
+	photos, _ := mock.Called(userId).Get(0).([]models.UserPhoto)
+	return photos
+}
+func (mock *MockAccountRepository) DeletePhoto(photoId int64) error {
+	return mock.Called(photoId).Error(0)
+}
+func (mock *MockAccountRepository) GetPhoto(photoId int64) *models.UserPhoto {
+	photo, _ := mock.Called(photoId).Get(0).(*models.UserPhoto)
+	return photo
+}
+func (mock *MockAccountRepository) GetLastUserPhoto(userId int64) *models.UserPhoto {
+	photo, _ := mock.Called(userId).Get(0).(*models.UserPhoto)
+	return photo
+}
+func (mock *MockAccountRepository) GetDistance(user *models.User) (*float64, error) {
+	args := mock.Called(user)
+	distance, _ := args.Get(0).(*float64)
+	return distance, args.Error(1)
+}
+func (mock *MockAccountRepository) GetPreferences(userId int64) *models.UserPreferences {
+	prefer, _ := mock.Called(userId).Get(0).(*models.UserPreferences)
+	return prefer
+}
+func (mock *MockAccountRepository) UpdateLocationRedis(key string, lonLat models.LonLat) error {
+	return mock.Called(key, lonLat).Error(0)
+}
+func (mock *MockAccountRepository) UpdatePreferences(prefer *models.UserPreferences) error {
+	return mock.Called(prefer).Error(0)
+}
+func (mock *MockAccountRepository) GetInterestedIn(userId int64) []string {
+	genders, _ := mock.Called(userId).Get(0).([]string)
+	return genders
+}
+func (mock *MockAccountRepository) SetInterestedIn(userId int64, genders []string) error {
+	return mock.Called(userId, genders).Error(0)
+}
+func (mock *MockAccountRepository) Block(blockerId, blockedId int64) error {
+	return mock.Called(blockerId, blockedId).Error(0)
+}
+func (mock *MockAccountRepository) IsBlocked(userId1, userId2 int64) (bool, error) {
+	args := mock.Called(userId1, userId2)
+	return args.Bool(0), args.Error(1)
+}
+func (mock *MockAccountRepository) CreateReport(report *models.Report) (int64, error) {
+	args := mock.Called(report)
+	return int64(args.Int(0)), args.Error(1)
+}
+func (mock *MockAccountRepository) RemoveCandidateFromRedis(candidatesKey string, userId int64) error {
+	return mock.Called(candidatesKey, userId).Error(0)
+}
+func (mock *MockAccountRepository) DeleteCandidatesFromRedis(userId int64) error {
+	return mock.Called(userId).Error(0)
+}
+func (mock *MockAccountRepository) IncrLocationJumps(userId int64, window time.Duration) (int64, time.Duration, error) {
+	args := mock.Called(userId, window)
+	return int64(args.Int(0)), args.Get(1).(time.Duration), args.Error(2)
+}
+func (mock *MockAccountRepository) GetOpenReports(cursor *int64, limit int32) ([]models.Report, error) {
+	args := mock.Called(cursor, limit)
+	reports, _ := args.Get(0).([]models.Report)
+	return reports, args.Error(1)
+}
+func (mock *MockAccountRepository) GetReport(reportId int64) *models.Report {
+	report, _ := mock.Called(reportId).Get(0).(*models.Report)
+	return report
+}
+func (mock *MockAccountRepository) ResolveReport(reportId, adminId int64, status string) error {
+	return mock.Called(reportId, adminId, status).Error(0)
+}
+func (mock *MockAccountRepository) GetReportsByUser(userId int64) []models.Report {
+	reports, _ := mock.Called(userId).Get(0).([]models.Report)
+	return reports
+}
+func (mock *MockAccountRepository) CreateSanction(sanction *models.Sanction) (int64, error) {
+	args := mock.Called(sanction)
+	return int64(args.Int(0)), args.Error(1)
+}
+func (mock *MockAccountRepository) GetSanctions(userId int64) []models.Sanction {
+	sanctions, _ := mock.Called(userId).Get(0).([]models.Sanction)
+	return sanctions
+}
+func (mock *MockAccountRepository) SetStatusRedis(userId int64, status models.UserStatus, expiration time.Duration) error {
+	return mock.Called(userId, status, expiration).Error(0)
+}
+func (mock *MockAccountRepository) CacheActiveStatus(userId int64, expiration time.Duration) error {
+	return mock.Called(userId, expiration).Error(0)
+}
+func (mock *MockAccountRepository) TouchLastActive(userId int64) error {
+	return mock.Called(userId).Error(0)
+}
+func (mock *MockAccountRepository) SetTravel(userId int64, location string, startsAt, endsAt time.Time) error {
+	return mock.Called(userId, location, startsAt, endsAt).Error(0)
+}
+func (mock *MockAccountRepository) GetTravel(userId int64) *models.Travel {
+	travel, _ := mock.Called(userId).Get(0).(*models.Travel)
+	return travel
+}
+func (mock *MockAccountRepository) EndTravel(userId int64) (bool, error) {
+	args := mock.Called(userId)
+	return args.Bool(0), args.Error(1)
+}
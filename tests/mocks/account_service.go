@@ -2,7 +2,9 @@ package mocks
 
 import (
 	"flame/internal/interfaces"
+	"flame/internal/models"
 	"flame/pkg/jwt"
+	"flame/pkg/pb"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -17,8 +19,8 @@ func (mock *MockAccountService) IssueToken(secret string, data jwt.Data) (*inter
 	}
 	return args.Get(0).(*interfaces.AccountSIssueToken), args.Error(1)
 }
-func (mock *MockAccountService) Login(email, password string) (int64, error) {
-	args := mock.Called(email, password)
+func (mock *MockAccountService) Login(email, password, location string) (int64, error) {
+	args := mock.Called(email, password, location)
 	return int64(args.Int(0)), args.Error(1)
 }
 func (mock *MockAccountService) Register(data *interfaces.AccountSRegisterDeps) (int64, error) {
@@ -32,3 +34,78 @@ func (mock *MockAccountService) GetTokens(secret string, data jwt.Data) (*interf
 	}
 	return args.Get(0).(*interfaces.AccountSIssueToken), args.Error(1)
 }
+func (mock *MockAccountService) UpdateProfile(data *pb.UpdateProfileReq) error {
+	return mock.Called(data).Error(0)
+}
+func (mock *MockAccountService) GetProfile(id int64) (*pb.GetProfileRes, error) {
+	args := mock.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GetProfileRes), args.Error(1)
+}
+func (mock *MockAccountService) UploadPhoto(userId int64, link string) error {
+	return mock.Called(userId, link).Error(0)
+}
+func (mock *MockAccountService) DeletePhoto(userId, photoId int64) (string, error) {
+	args := mock.Called(userId, photoId)
+	return args.String(0), args.Error(1)
+}
+func (mock *MockAccountService) UpdateLocation(userId int64, location string) (bool, error) {
+	args := mock.Called(userId, location)
+	return args.Bool(0), args.Error(1)
+}
+func (mock *MockAccountService) UpdatePreferences(prefer *pb.UpdatePreferencesReq) error {
+	return mock.Called(prefer).Error(0)
+}
+func (mock *MockAccountService) Block(userId, targetId int64) error {
+	return mock.Called(userId, targetId).Error(0)
+}
+func (mock *MockAccountService) Report(report *models.Report) error {
+	return mock.Called(report).Error(0)
+}
+func (mock *MockAccountService) IsBlocked(userId1, userId2 int64) (bool, error) {
+	args := mock.Called(userId1, userId2)
+	return args.Bool(0), args.Error(1)
+}
+func (mock *MockAccountService) GetReports(adminId int64, cursor *int64, limit int32) ([]models.Report, *int64, error) {
+	args := mock.Called(adminId, cursor, limit)
+	reports, _ := args.Get(0).([]models.Report)
+	next, _ := args.Get(1).(*int64)
+	return reports, next, args.Error(2)
+}
+func (mock *MockAccountService) ResolveReport(adminId, reportId int64, status string) error {
+	return mock.Called(adminId, reportId, status).Error(0)
+}
+func (mock *MockAccountService) GetReportedUser(adminId, userId int64) (*pb.GetReportedUserRes, error) {
+	args := mock.Called(adminId, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pb.GetReportedUserRes), args.Error(1)
+}
+func (mock *MockAccountService) SanctionUser(adminId int64, sanction *models.Sanction) error {
+	return mock.Called(adminId, sanction).Error(0)
+}
+func (mock *MockAccountService) RemovePhoto(adminId, photoId int64) (string, error) {
+	args := mock.Called(adminId, photoId)
+	return args.String(0), args.Error(1)
+}
+func (mock *MockAccountService) GetStatus(userId int64) (models.UserStatus, error) {
+	args := mock.Called(userId)
+	return args.Get(0).(models.UserStatus), args.Error(1)
+}
+func (mock *MockAccountService) SetTravel(userId int64, location string, startsAt *string, endsAt string) error {
+	return mock.Called(userId, location, startsAt, endsAt).Error(0)
+}
+func (mock *MockAccountService) GetTravel(userId int64) *models.Travel {
+	args := mock.Called(userId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*models.Travel)
+}
+func (mock *MockAccountService) EndTravel(userId int64) (bool, error) {
+	args := mock.Called(userId)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,88 @@
+package mocks
+
+import (
+	"flame/internal/models"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type MockMatchingRepository struct {
+	mock.Mock
+}
+
+func (mock *MockMatchingRepository) GetMatchingUsers(userId int64, strict bool, nearbyIds []int64) ([]models.GetMatchingUser, error) {
+	args := mock.Called(userId, strict, nearbyIds)
+	users, _ := args.Get(0).([]models.GetMatchingUser)
+	return users, args.Error(1)
+}
+func (mock *MockMatchingRepository) GetLonLat(userId int64) *models.LonLat {
+	args := mock.Called(userId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*models.LonLat)
+}
+func (mock *MockMatchingRepository) DeleteDuplicateMatch(userId int64, users []models.GetMatchingUser) []models.GetMatchingUser {
+	args := mock.Called(userId, users)
+	res, _ := args.Get(0).([]models.GetMatchingUser)
+	return res
+}
+func (mock *MockMatchingRepository) GetLikes(userId int64, after *models.Like, limit int32) ([]models.Like, error) {
+	args := mock.Called(userId, after, limit)
+	likes, _ := args.Get(0).([]models.Like)
+	return likes, args.Error(1)
+}
+func (mock *MockMatchingRepository) GetUsersByIds(userId int64, ids []int64) ([]models.GetMatchingUser, error) {
+	args := mock.Called(userId, ids)
+	users, _ := args.Get(0).([]models.GetMatchingUser)
+	return users, args.Error(1)
+}
+func (mock *MockMatchingRepository) GetSuperLikerIds(userId int64) ([]int64, error) {
+	args := mock.Called(userId)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
+func (mock *MockMatchingRepository) GetPreferences(userId int64) *models.UserPreferences {
+	args := mock.Called(userId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*models.UserPreferences)
+}
+func (mock *MockMatchingRepository) GetLikerIds(userId int64) ([]int64, error) {
+	args := mock.Called(userId)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
+func (mock *MockMatchingRepository) GetCandidate(userId int64) *models.GetMatchingUser {
+	args := mock.Called(userId)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*models.GetMatchingUser)
+}
+func (mock *MockMatchingRepository) GetPoolViewers(userId int64, strict bool) ([]models.PoolViewer, error) {
+	args := mock.Called(userId, strict)
+	viewers, _ := args.Get(0).([]models.PoolViewer)
+	return viewers, args.Error(1)
+}
+func (mock *MockMatchingRepository) GetSwipedByIds(userId int64) ([]int64, error) {
+	args := mock.Called(userId)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
+func (mock *MockMatchingRepository) GetLikedIds(userId int64) ([]int64, error) {
+	args := mock.Called(userId)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
+func (mock *MockMatchingRepository) GetLocatedUsers() ([]models.UserLocation, error) {
+	args := mock.Called()
+	users, _ := args.Get(0).([]models.UserLocation)
+	return users, args.Error(1)
+}
+func (mock *MockMatchingRepository) GetTravelChangedIds(since, until time.Time) ([]int64, error) {
+	args := mock.Called(since, until)
+	ids, _ := args.Get(0).([]int64)
+	return ids, args.Error(1)
+}
@@ -0,0 +1,496 @@
+package mocks
+
+import (
+	"bufio"
+	"errors"
+	"flame/pkg/db"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Redis is an in-memory server speaking enough of the Redis protocol for the commands the
+// services use: strings, hashes, sets, sorted sets, GEOADD, streams with consumer groups,
+// expiration and MULTI/EXEC. It is not a full implementation, unknown commands are answered with
+// an error.
+type Redis struct {
+	mu      sync.Mutex
+	values  map[string]any
+	expires map[string]time.Time
+	// Calls counts the commands received by name, in upper case.
+	Calls map[string]int
+}
+
+// NewRedis starts the server and returns a client connected to it. Both are closed when the
+// test ends.
+func NewRedis(t *testing.T) (*Redis, *db.Redis) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &Redis{
+		values:  make(map[string]any),
+		expires: make(map[string]time.Time),
+		Calls:   make(map[string]int),
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	client := db.NewRedis(&redis.Options{Addr: listener.Addr().String()})
+	t.Cleanup(func() {
+		client.Close()
+		listener.Close()
+	})
+	return server, client
+}
+
+// Flush drops every key, like an eviction of the whole cache.
+func (server *Redis) Flush() {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.values = make(map[string]any)
+	server.expires = make(map[string]time.Time)
+}
+
+// TTL returns the time left before key expires, or 0 if it has no expiration.
+func (server *Redis) TTL(key string) time.Duration {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	at, ok := server.expires[key]
+	if !ok {
+		return 0
+	}
+	return time.Until(at)
+}
+
+type respError string
+
+func (server *Redis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	writer := bufio.NewWriter(conn)
+	var queued [][]string
+	inMulti := false
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		name := strings.ToUpper(args[0])
+		switch {
+		case name == "MULTI":
+			inMulti = true
+			queued = nil
+			writeReply(writer, "OK")
+		case name == "EXEC":
+			inMulti = false
+			replies := make([]any, len(queued))
+			for i, cmd := range queued {
+				replies[i] = server.exec(cmd)
+			}
+			writeReply(writer, replies)
+		case inMulti:
+			queued = append(queued, args)
+			writeReply(writer, "QUEUED")
+		default:
+			writeReply(writer, server.exec(args))
+		}
+		if reader.Buffered() == 0 {
+			if writer.Flush() != nil {
+				return
+			}
+		}
+	}
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return nil, errors.New("expected an array")
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		line, err = reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		_, err = io.ReadFull(reader, buf)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+// writeReply encodes string as a status, []byte as a bulk string, nil as a null bulk string.
+func writeReply(writer *bufio.Writer, reply any) {
+	switch v := reply.(type) {
+	case nil:
+		writer.WriteString("$-1\r\n")
+	case string:
+		fmt.Fprintf(writer, "+%s\r\n", v)
+	case respError:
+		fmt.Fprintf(writer, "-%s\r\n", v)
+	case int64:
+		fmt.Fprintf(writer, ":%d\r\n", v)
+	case []byte:
+		fmt.Fprintf(writer, "$%d\r\n%s\r\n", len(v), v)
+	case []any:
+		fmt.Fprintf(writer, "*%d\r\n", len(v))
+		for _, item := range v {
+			writeReply(writer, item)
+		}
+	}
+}
+
+func bulks(values []string) []any {
+	res := make([]any, len(values))
+	for i, v := range values {
+		res[i] = []byte(v)
+	}
+	return res
+}
+
+func (server *Redis) exec(args []string) any {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	name := strings.ToUpper(args[0])
+	server.Calls[name]++
+	for key, at := range server.expires {
+		if time.Now().After(at) {
+			delete(server.values, key)
+			delete(server.expires, key)
+		}
+	}
+	switch name {
+	case "PING":
+		return "PONG"
+	case "GET":
+		v, ok := server.values[args[1]].(string)
+		if !ok {
+			return nil
+		}
+		return []byte(v)
+	case "SET":
+		return server.setString(args)
+	case "INCR":
+		v, _ := server.values[args[1]].(string)
+		n, _ := strconv.ParseInt(v, 10, 64)
+		n++
+		server.values[args[1]] = strconv.FormatInt(n, 10)
+		return n
+	case "DEL":
+		var n int64
+		for _, key := range args[1:] {
+			if _, ok := server.values[key]; ok {
+				delete(server.values, key)
+				delete(server.expires, key)
+				n++
+			}
+		}
+		return n
+	case "EXISTS":
+		var n int64
+		for _, key := range args[1:] {
+			if _, ok := server.values[key]; ok {
+				n++
+			}
+		}
+		return n
+	case "EXPIRE", "PEXPIRE":
+		if _, ok := server.values[args[1]]; !ok {
+			return int64(0)
+		}
+		n, _ := strconv.ParseInt(args[2], 10, 64)
+		unit := time.Second
+		if name == "PEXPIRE" {
+			unit = time.Millisecond
+		}
+		server.expires[args[1]] = time.Now().Add(time.Duration(n) * unit)
+		return int64(1)
+	case "TTL", "PTTL":
+		if _, ok := server.values[args[1]]; !ok {
+			return int64(-2)
+		}
+		at, ok := server.expires[args[1]]
+		if !ok {
+			return int64(-1)
+		}
+		if name == "PTTL" {
+			return time.Until(at).Milliseconds()
+		}
+		return int64(time.Until(at).Seconds())
+	case "HSET":
+		hash := server.hashAt(args[1])
+		var n int64
+		for i := 2; i+1 < len(args); i += 2 {
+			if _, ok := hash[args[i]]; !ok {
+				n++
+			}
+			hash[args[i]] = args[i+1]
+		}
+		return n
+	case "HGET":
+		v, ok := server.hashAt(args[1])[args[2]]
+		server.dropEmpty(args[1])
+		if !ok {
+			return nil
+		}
+		return []byte(v)
+	case "HGETALL":
+		var res []string
+		for field, v := range server.hashAt(args[1]) {
+			res = append(res, field, v)
+		}
+		server.dropEmpty(args[1])
+		return bulks(res)
+	case "HDEL":
+		hash := server.hashAt(args[1])
+		var n int64
+		for _, field := range args[2:] {
+			if _, ok := hash[field]; ok {
+				delete(hash, field)
+				n++
+			}
+		}
+		server.dropEmpty(args[1])
+		return n
+	case "SADD":
+		set := server.setAt(args[1])
+		var n int64
+		for _, member := range args[2:] {
+			if _, ok := set[member]; !ok {
+				set[member] = struct{}{}
+				n++
+			}
+		}
+		return n
+	case "SREM":
+		set := server.setAt(args[1])
+		var n int64
+		for _, member := range args[2:] {
+			if _, ok := set[member]; ok {
+				delete(set, member)
+				n++
+			}
+		}
+		server.dropEmpty(args[1])
+		return n
+	case "SMEMBERS":
+		var members []string
+		for member := range server.setAt(args[1]) {
+			members = append(members, member)
+		}
+		server.dropEmpty(args[1])
+		sort.Strings(members)
+		return bulks(members)
+	case "ZADD":
+		zset := server.zsetAt(args[1])
+		var n int64
+		for i := 2; i+1 < len(args); i += 2 {
+			score, err := strconv.ParseFloat(args[i], 64)
+			if err != nil {
+				return respError("ERR value is not a valid float")
+			}
+			if _, ok := zset[args[i+1]]; !ok {
+				n++
+			}
+			zset[args[i+1]] = score
+		}
+		return n
+	case "ZREM":
+		zset := server.zsetAt(args[1])
+		var n int64
+		for _, member := range args[2:] {
+			if _, ok := zset[member]; ok {
+				delete(zset, member)
+				n++
+			}
+		}
+		server.dropEmpty(args[1])
+		return n
+	case "ZSCORE":
+		score, ok := server.zsetAt(args[1])[args[2]]
+		server.dropEmpty(args[1])
+		if !ok {
+			return nil
+		}
+		return []byte(strconv.FormatFloat(score, 'f', -1, 64))
+	case "ZRANGEBYSCORE":
+		// Only inclusive bounds are supported, without WITHSCORES or LIMIT.
+		min, errMin := strconv.ParseFloat(args[2], 64)
+		max, errMax := strconv.ParseFloat(args[3], 64)
+		if errMin != nil || errMax != nil {
+			return respError("ERR min or max is not a float")
+		}
+		zset := server.zsetAt(args[1])
+		members := make([]string, 0, len(zset))
+		for member, score := range zset {
+			if score >= min && score <= max {
+				members = append(members, member)
+			}
+		}
+		server.dropEmpty(args[1])
+		sort.Slice(members, func(i, j int) bool {
+			if zset[members[i]] != zset[members[j]] {
+				return zset[members[i]] < zset[members[j]]
+			}
+			return members[i] < members[j]
+		})
+		return bulks(members)
+	case "GEOADD":
+		// Members are stored without their position, tests only look at membership.
+		zset := server.zsetAt(args[1])
+		var n int64
+		for i := 2; i+2 < len(args); i += 3 {
+			if _, ok := zset[args[i+2]]; !ok {
+				n++
+			}
+			zset[args[i+2]] = 0
+		}
+		return n
+	case "XADD", "XGROUP", "XREADGROUP", "XACK", "XPENDING", "XAUTOCLAIM":
+		return server.execStream(name, args)
+	}
+	return respError(fmt.Sprintf("ERR unknown command '%s'", args[0]))
+}
+
+func (server *Redis) setString(args []string) any {
+	key, value := args[1], args[2]
+	var ttl time.Duration
+	nx := false
+	for i := 3; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "NX":
+			nx = true
+		case "EX", "PX":
+			n, _ := strconv.ParseInt(args[i+1], 10, 64)
+			ttl = time.Duration(n) * time.Second
+			if strings.ToUpper(args[i]) == "PX" {
+				ttl = time.Duration(n) * time.Millisecond
+			}
+			i++
+		}
+	}
+	if _, ok := server.values[key]; ok && nx {
+		return nil
+	}
+	server.values[key] = value
+	delete(server.expires, key)
+	if ttl > 0 {
+		server.expires[key] = time.Now().Add(ttl)
+	}
+	return "OK"
+}
+
+func (server *Redis) hashAt(key string) map[string]string {
+	hash, ok := server.values[key].(map[string]string)
+	if !ok {
+		hash = make(map[string]string)
+		server.values[key] = hash
+	}
+	return hash
+}
+
+func (server *Redis) setAt(key string) map[string]struct{} {
+	set, ok := server.values[key].(map[string]struct{})
+	if !ok {
+		set = make(map[string]struct{})
+		server.values[key] = set
+	}
+	return set
+}
+
+func (server *Redis) zsetAt(key string) map[string]float64 {
+	zset, ok := server.values[key].(map[string]float64)
+	if !ok {
+		zset = make(map[string]float64)
+		server.values[key] = zset
+	}
+	return zset
+}
+
+// dropEmpty removes a collection left empty, Redis does not keep empty keys.
+func (server *Redis) dropEmpty(key string) {
+	switch v := server.values[key].(type) {
+	case map[string]string:
+		if len(v) == 0 {
+			delete(server.values, key)
+		}
+	case map[string]struct{}:
+		if len(v) == 0 {
+			delete(server.values, key)
+		}
+	case map[string]float64:
+		if len(v) == 0 {
+			delete(server.values, key)
+		}
+	}
+}
+
+// Hash returns a copy of the hash stored at key.
+func (server *Redis) Hash(key string) map[string]string {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	res := make(map[string]string)
+	if hash, ok := server.values[key].(map[string]string); ok {
+		for k, v := range hash {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// Members returns the sorted members of the set or sorted set stored at key.
+func (server *Redis) Members(key string) []string {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	var members []string
+	switch v := server.values[key].(type) {
+	case map[string]struct{}:
+		for member := range v {
+			members = append(members, member)
+		}
+	case map[string]float64:
+		for member := range v {
+			members = append(members, member)
+		}
+	}
+	sort.Strings(members)
+	return members
+}
+
+// Get returns the string stored at key and whether it exists.
+func (server *Redis) Get(key string) (string, bool) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	v, ok := server.values[key].(string)
+	return v, ok
+}
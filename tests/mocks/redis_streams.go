@@ -0,0 +1,256 @@
+package mocks
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type stream struct {
+	entries []streamEntry
+	groups  map[string]*streamGroup
+	seq     int64
+}
+
+type streamEntry struct {
+	id     string
+	fields []string
+}
+
+type streamGroup struct {
+	lastDelivered string
+	pending       map[string]*pendingEntry
+}
+
+type pendingEntry struct {
+	consumer    string
+	deliveries  int64
+	deliveredAt time.Time
+}
+
+// compareIds orders stream ids of the form "ms-seq".
+func compareIds(a, b string) int {
+	aMs, aSeq := splitId(a)
+	bMs, bSeq := splitId(b)
+	switch {
+	case aMs != bMs && aMs < bMs, aMs == bMs && aSeq < bSeq:
+		return -1
+	case aMs == bMs && aSeq == bSeq:
+		return 0
+	}
+	return 1
+}
+
+func splitId(id string) (int64, int64) {
+	ms, seq, _ := strings.Cut(id, "-")
+	msN, _ := strconv.ParseInt(ms, 10, 64)
+	seqN, _ := strconv.ParseInt(seq, 10, 64)
+	return msN, seqN
+}
+
+func (server *Redis) streamAt(key string, create bool) *stream {
+	s, ok := server.values[key].(*stream)
+	if !ok && create {
+		s = &stream{groups: make(map[string]*streamGroup)}
+		server.values[key] = s
+	}
+	return s
+}
+
+func (s *stream) entry(id string) (streamEntry, bool) {
+	for _, entry := range s.entries {
+		if entry.id == id {
+			return entry, true
+		}
+	}
+	return streamEntry{}, false
+}
+
+func entryReply(entry streamEntry) []any {
+	return []any{[]byte(entry.id), bulks(entry.fields)}
+}
+
+// execStream runs the stream commands. Only the forms the services send are understood: XADD
+// with an automatic id, the extended XPENDING and XREADGROUP on a single stream.
+func (server *Redis) execStream(name string, args []string) any {
+	switch name {
+	case "XADD":
+		s := server.streamAt(args[1], true)
+		i := 2
+		for i < len(args) && args[i] != "*" {
+			i++
+		}
+		if i == len(args) {
+			return respError("ERR only automatic ids are supported")
+		}
+		s.seq++
+		entry := streamEntry{id: fmt.Sprintf("%d-0", s.seq), fields: append([]string(nil), args[i+1:]...)}
+		s.entries = append(s.entries, entry)
+		return []byte(entry.id)
+	case "XGROUP":
+		if strings.ToUpper(args[1]) != "CREATE" {
+			return respError("ERR unknown XGROUP subcommand")
+		}
+		mkStream := len(args) > 5 && strings.ToUpper(args[5]) == "MKSTREAM"
+		s := server.streamAt(args[2], mkStream)
+		if s == nil {
+			return respError("ERR The XGROUP subcommand requires the key to exist")
+		}
+		if _, ok := s.groups[args[3]]; ok {
+			return respError("BUSYGROUP Consumer Group name already exists")
+		}
+		start := args[4]
+		if start == "$" {
+			start = fmt.Sprintf("%d-0", s.seq)
+		}
+		s.groups[args[3]] = &streamGroup{lastDelivered: start, pending: make(map[string]*pendingEntry)}
+		return "OK"
+	case "XREADGROUP":
+		return server.readGroup(args)
+	case "XACK":
+		s := server.streamAt(args[1], false)
+		if s == nil || s.groups[args[2]] == nil {
+			return int64(0)
+		}
+		var n int64
+		for _, id := range args[3:] {
+			if _, ok := s.groups[args[2]].pending[id]; ok {
+				delete(s.groups[args[2]].pending, id)
+				n++
+			}
+		}
+		return n
+	case "XPENDING":
+		s := server.streamAt(args[1], false)
+		if s == nil || s.groups[args[2]] == nil {
+			return respError("NOGROUP No such key or consumer group")
+		}
+		if len(args) < 6 {
+			return respError("ERR only the extended form is supported")
+		}
+		start, end := args[3], args[4]
+		count, _ := strconv.Atoi(args[5])
+		var res []any
+		for _, id := range s.pendingIds(args[2]) {
+			entry := s.groups[args[2]].pending[id]
+			if len(res) == count || (end != "+" && compareIds(id, end) > 0) {
+				break
+			}
+			if start != "-" && compareIds(id, start) < 0 || len(args) > 6 && entry.consumer != args[6] {
+				continue
+			}
+			res = append(res, []any{[]byte(id), []byte(entry.consumer),
+				time.Since(entry.deliveredAt).Milliseconds(), entry.deliveries})
+		}
+		return res
+	case "XAUTOCLAIM":
+		s := server.streamAt(args[1], false)
+		if s == nil || s.groups[args[2]] == nil {
+			return respError("NOGROUP No such key or consumer group")
+		}
+		minIdle, _ := strconv.ParseInt(args[4], 10, 64)
+		var claimed []any
+		for _, id := range s.pendingIds(args[2]) {
+			entry := s.groups[args[2]].pending[id]
+			if time.Since(entry.deliveredAt).Milliseconds() < minIdle {
+				continue
+			}
+			entry.consumer = args[3]
+			entry.deliveries++
+			entry.deliveredAt = time.Now()
+			if e, ok := s.entry(id); ok {
+				claimed = append(claimed, entryReply(e))
+			}
+		}
+		return []any{[]byte("0-0"), claimed}
+	}
+	return nil
+}
+
+func (s *stream) pendingIds(group string) []string {
+	ids := make([]string, 0, len(s.groups[group].pending))
+	for id := range s.groups[group].pending {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return compareIds(ids[i], ids[j]) < 0
+	})
+	return ids
+}
+
+// readGroup answers XREADGROUP: ">" delivers new entries, any other id re-delivers the pending
+// entries of the consumer after it, counting the delivery like Redis does.
+func (server *Redis) readGroup(args []string) any {
+	group, consumer := args[2], args[3]
+	count := -1
+	var key, start string
+	for i := 4; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "COUNT":
+			count, _ = strconv.Atoi(args[i+1])
+			i++
+		case "BLOCK":
+			i++
+		case "STREAMS":
+			key, start = args[i+1], args[i+2]
+			i = len(args)
+		}
+	}
+	s := server.streamAt(key, false)
+	if s == nil || s.groups[group] == nil {
+		return respError("NOGROUP No such key or consumer group")
+	}
+	g := s.groups[group]
+	var messages []any
+	if start == ">" {
+		for _, entry := range s.entries {
+			if len(messages) == count {
+				break
+			}
+			if compareIds(entry.id, g.lastDelivered) <= 0 {
+				continue
+			}
+			g.lastDelivered = entry.id
+			g.pending[entry.id] = &pendingEntry{consumer: consumer, deliveries: 1, deliveredAt: time.Now()}
+			messages = append(messages, entryReply(entry))
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+		return []any{[]any{[]byte(key), messages}}
+	}
+	for _, id := range s.pendingIds(group) {
+		pending := g.pending[id]
+		if len(messages) == count {
+			break
+		}
+		if pending.consumer != consumer || compareIds(id, start) <= 0 {
+			continue
+		}
+		pending.deliveries++
+		pending.deliveredAt = time.Now()
+		if entry, ok := s.entry(id); ok {
+			messages = append(messages, entryReply(entry))
+		}
+	}
+	return []any{[]any{[]byte(key), messages}}
+}
+
+// Stream returns the fields of the entries of the stream stored at key, in order.
+func (server *Redis) Stream(key string) []map[string]string {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	var res []map[string]string
+	if s, ok := server.values[key].(*stream); ok {
+		for _, entry := range s.entries {
+			fields := make(map[string]string)
+			for i := 0; i+1 < len(entry.fields); i += 2 {
+				fields[entry.fields[i]] = entry.fields[i+1]
+			}
+			res = append(res, fields)
+		}
+	}
+	return res
+}
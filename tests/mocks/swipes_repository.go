@@ -0,0 +1,92 @@
+package mocks
+
+import (
+	"flame/internal/models"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type MockSwipesRepository struct {
+	mock.Mock
+}
+
+func (mock *MockSwipesRepository) CreateOrUpdate(userId1, userId2 int64, kind models.SwipeKind) error {
+	return mock.Called(userId1, userId2, kind).Error(0)
+}
+func (mock *MockSwipesRepository) GetUnreadSwipes(userId int64) []int64 {
+	ids, _ := mock.Called(userId).Get(0).([]int64)
+	return ids
+}
+func (mock *MockSwipesRepository) CountUnreadSwipes(userId int64) (int64, error) {
+	args := mock.Called(userId)
+	return int64(args.Int(0)), args.Error(1)
+}
+func (mock *MockSwipesRepository) GetSwipeById(userId1, userId2 int64) *models.Swipe {
+	swipe, _ := mock.Called(userId1, userId2).Get(0).(*models.Swipe)
+	return swipe
+}
+func (mock *MockSwipesRepository) RemoveSwipeFromRedis(candidateListKey string, userId int64) error {
+	return mock.Called(candidateListKey, userId).Error(0)
+}
+func (mock *MockSwipesRepository) CreateMatch(userId1, userId2 int64) (*models.Match, error) {
+	args := mock.Called(userId1, userId2)
+	match, _ := args.Get(0).(*models.Match)
+	return match, args.Error(1)
+}
+func (mock *MockSwipesRepository) GetMatches(userId int64) []models.Match {
+	matches, _ := mock.Called(userId).Get(0).([]models.Match)
+	return matches
+}
+func (mock *MockSwipesRepository) GetMatch(matchId int64) *models.Match {
+	match, _ := mock.Called(matchId).Get(0).(*models.Match)
+	return match
+}
+func (mock *MockSwipesRepository) GetMatchByUsers(userId1, userId2 int64) *models.Match {
+	match, _ := mock.Called(userId1, userId2).Get(0).(*models.Match)
+	return match
+}
+func (mock *MockSwipesRepository) Unmatch(match *models.Match, initiatorId int64) error {
+	return mock.Called(match, initiatorId).Error(0)
+}
+func (mock *MockSwipesRepository) IsUnmatched(userId1, userId2 int64) bool {
+	return mock.Called(userId1, userId2).Bool(0)
+}
+func (mock *MockSwipesRepository) GetLastSwipeEvent(swiperId int64) *models.SwipeEvent {
+	event, _ := mock.Called(swiperId).Get(0).(*models.SwipeEvent)
+	return event
+}
+func (mock *MockSwipesRepository) HasMatch(userId1, userId2 int64) bool {
+	return mock.Called(userId1, userId2).Bool(0)
+}
+func (mock *MockSwipesRepository) Rewind(event *models.SwipeEvent) error {
+	return mock.Called(event).Error(0)
+}
+func (mock *MockSwipesRepository) AddSwipeToRedis(candidateListKey string, userId int64) error {
+	return mock.Called(candidateListKey, userId).Error(0)
+}
+func (mock *MockSwipesRepository) IncrSuperLikes(userId int64, day time.Time) (int64, error) {
+	args := mock.Called(userId, day)
+	return int64(args.Int(0)), args.Error(1)
+}
+func (mock *MockSwipesRepository) DecrSuperLikes(userId int64, day time.Time) error {
+	return mock.Called(userId, day).Error(0)
+}
+func (mock *MockSwipesRepository) GetSuperLikes(userId int64, day time.Time) (int64, error) {
+	args := mock.Called(userId, day)
+	return int64(args.Int(0)), args.Error(1)
+}
+func (mock *MockSwipesRepository) ReserveLike(userId, targetId int64, now time.Time, window time.Duration) (int64, time.Time, bool, error) {
+	args := mock.Called(userId, targetId, now, window)
+	return int64(args.Int(0)), args.Get(1).(time.Time), args.Bool(2), args.Error(3)
+}
+func (mock *MockSwipesRepository) ReleaseLike(userId, targetId int64) error {
+	return mock.Called(userId, targetId).Error(0)
+}
+func (mock *MockSwipesRepository) GetLikesInWindow(userId int64, now time.Time, window time.Duration) (int64, time.Time, error) {
+	args := mock.Called(userId, now, window)
+	return int64(args.Int(0)), args.Get(1).(time.Time), args.Error(2)
+}
+func (mock *MockSwipesRepository) CountLikesSince(userId int64, since time.Time) (int64, time.Time, error) {
+	args := mock.Called(userId, since)
+	return int64(args.Int(0)), args.Get(1).(time.Time), args.Error(2)
+}